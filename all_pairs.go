@@ -0,0 +1,226 @@
+package datastructures
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// AllPairsAlgorithm selects how AllPairsShortestPaths computes its matrix.
+type AllPairsAlgorithm int
+
+const (
+	AutoSelect    AllPairsAlgorithm = iota // Johnson for sparse graphs, Floyd-Warshall for dense ones
+	FloydWarshall                          // O(n^3); simplest and fastest on small or dense graphs
+	Johnson                                // O(n * e * logn); one Dijkstra per node after reweighting, best on sparse graphs
+)
+
+// DistanceMatrix holds the shortest distance between every ordered pair of
+// nodes, and enough predecessor information to rebuild each path.
+type DistanceMatrix[T constraints.Ordered] struct {
+	Algorithm AllPairsAlgorithm // the algorithm that produced the matrix; never AutoSelect
+	index     map[T]int
+	keys      []T
+	dist      [][]float64
+	pred      [][]int // pred[i][j] is the node before j on the shortest path from i, or -1
+}
+
+// Distance returns the length of the shortest path from a to b and whether b
+// is reachable from a.
+func (m *DistanceMatrix[T]) Distance(a T, b T) (float64, bool) {
+	i, okA := m.index[a]
+	j, okB := m.index[b]
+	if !okA || !okB || math.IsInf(m.dist[i][j], 1) {
+		return math.Inf(1), false
+	}
+	return m.dist[i][j], true
+}
+
+// Path returns the nodes on the shortest path from a to b, both included, and
+// whether b is reachable from a.
+func (m *DistanceMatrix[T]) Path(a T, b T) ([]T, bool) {
+	if _, ok := m.Distance(a, b); !ok {
+		return nil, false
+	}
+	i, j := m.index[a], m.index[b]
+	path := []T{b}
+	for j != i {
+		j = m.pred[i][j]
+		path = append(path, m.keys[j])
+	}
+	for l, r := 0, len(path)-1; l < r; l, r = l+1, r-1 {
+		path[l], path[r] = path[r], path[l]
+	}
+	return path, true
+}
+
+// AllPairsShortestPaths returns the shortest distance between every pair of
+// nodes. A negative edge in an undirected graph is a negative cycle, so it
+// returns a *NegativeCycleError.
+func (g *Graph[T]) AllPairsShortestPaths(algorithm AllPairsAlgorithm) (*DistanceMatrix[T], error) {
+	return g.view().allPairs(algorithm)
+}
+
+// AllPairsShortestPaths returns the shortest distance along outgoing edges
+// between every ordered pair of nodes. Negative weights are allowed; a
+// negative cycle returns a *NegativeCycleError.
+func (g *DirectedGraph[T]) AllPairsShortestPaths(algorithm AllPairsAlgorithm) (*DistanceMatrix[T], error) {
+	return g.view().allPairs(algorithm)
+}
+
+func (v graphView[T]) allPairs(algorithm AllPairsAlgorithm) (*DistanceMatrix[T], error) {
+	if algorithm == AutoSelect {
+		algorithm = v.pickAllPairsAlgorithm()
+	}
+
+	keys := make([]T, 0, len(v.nodes))
+	for node := range v.nodes {
+		keys = append(keys, node)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	m := &DistanceMatrix[T]{
+		Algorithm: algorithm,
+		index:     make(map[T]int, len(keys)),
+		keys:      keys,
+		dist:      make([][]float64, len(keys)),
+		pred:      make([][]int, len(keys)),
+	}
+	for i, key := range keys {
+		m.index[key] = i
+		m.dist[i] = make([]float64, len(keys))
+		m.pred[i] = make([]int, len(keys))
+		for j := range keys {
+			m.dist[i][j] = math.Inf(1)
+			m.pred[i][j] = -1
+		}
+		m.dist[i][i] = 0
+	}
+
+	var err error
+	if algorithm == Johnson {
+		err = v.johnson(m)
+	} else {
+		err = v.floydWarshall(m)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// pickAllPairsAlgorithm prefers Johnson when n Dijkstra runs, O(n * e * logn),
+// beat Floyd-Warshall's O(n^3), i.e. when e * logn < n^2.
+func (v graphView[T]) pickAllPairsAlgorithm() AllPairsAlgorithm {
+	n := float64(len(v.nodes))
+	edges := 0
+	for _, neighbors := range v.adj {
+		edges += len(neighbors)
+	}
+	if float64(edges)*math.Log2(n+1) < n*n {
+		return Johnson
+	}
+	return FloydWarshall
+}
+
+func (v graphView[T]) floydWarshall(m *DistanceMatrix[T]) error {
+	for from, neighbors := range v.adj {
+		i := m.index[from]
+		for to := range neighbors {
+			j := m.index[to]
+			if weight := v.weights[from][to]; weight < m.dist[i][j] {
+				m.dist[i][j] = weight
+				m.pred[i][j] = i
+			}
+		}
+	}
+	n := len(m.keys)
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(m.dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if candidate := m.dist[i][k] + m.dist[k][j]; candidate < m.dist[i][j] {
+					m.dist[i][j] = candidate
+					m.pred[i][j] = m.pred[k][j]
+				}
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if m.dist[i][i] < 0 {
+			_, err := v.potentials()
+			return err
+		}
+	}
+	return nil
+}
+
+func (v graphView[T]) johnson(m *DistanceMatrix[T]) error {
+	h, err := v.potentials()
+	if err != nil {
+		return err
+	}
+	// Reweighting by w + h[from] - h[to] makes every weight non-negative while
+	// keeping the same shortest paths, so Dijkstra can run from every node.
+	reweighted := graphView[T]{
+		nodes:   v.nodes,
+		adj:     v.adj,
+		weights: make(map[T]map[T]float64, len(v.weights)),
+		order:   v.order,
+		rank:    v.rank,
+	}
+	for from, neighbors := range v.adj {
+		reweighted.weights[from] = make(map[T]float64, len(neighbors))
+		for to := range neighbors {
+			reweighted.weights[from][to] = math.Max(0, v.weights[from][to]+h[from]-h[to])
+		}
+	}
+	for i, src := range m.keys {
+		sp, err := reweighted.dijkstra(src)
+		if err != nil {
+			return err
+		}
+		for node, dist := range sp.Dist {
+			j := m.index[node]
+			m.dist[i][j] = dist - h[src] + h[node]
+			if prev, ok := sp.Prev[node]; ok {
+				m.pred[i][j] = m.index[prev]
+			}
+		}
+	}
+	return nil
+}
+
+// potentials runs Bellman-Ford from an imaginary source with a 0-weight edge
+// to every node, returning each node's distance from it. Starting every node at
+// 0 stands in for the imaginary source.
+func (v graphView[T]) potentials() (map[T]float64, error) {
+	h := make(map[T]float64, len(v.nodes))
+	for node := range v.nodes {
+		h[node] = 0
+	}
+	prev := make(map[T]T)
+	nodes, adj := v.orderedAdjacency()
+	for i := 0; i <= len(v.nodes); i++ {
+		var changed T
+		anyChanged := false
+		for _, from := range nodes {
+			for _, to := range adj[from] {
+				if candidate := h[from] + v.weights[from][to]; candidate < h[to] {
+					h[to] = candidate
+					prev[to] = from
+					changed, anyChanged = to, true
+				}
+			}
+		}
+		if !anyChanged {
+			return h, nil
+		}
+		if i == len(v.nodes) {
+			return nil, &NegativeCycleError[T]{Cycle: negativeCycle(prev, changed, len(v.nodes)+1)}
+		}
+	}
+	return h, nil
+}
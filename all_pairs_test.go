@@ -0,0 +1,154 @@
+package datastructures
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for AllPairsShortestPaths */
+/*--------------------------------------------------------------------------------------------------*/
+func TestAllPairsShortestPaths(t *testing.T) {
+
+	// Happy Path
+	t.Run("Both algorithms agree on an undirected road map", func(t *testing.T) {
+		g := cityGraph()
+
+		for _, algorithm := range []AllPairsAlgorithm{FloydWarshall, Johnson} {
+			m, err := g.AllPairsShortestPaths(algorithm)
+			assert.NoError(t, err)
+			assert.Equal(t, algorithm, m.Algorithm)
+
+			dist, ok := m.Distance("DC", "Portland")
+			assert.True(t, ok)
+			assert.Equal(t, 560.0, dist)
+			path, ok := m.Path("DC", "Portland")
+			assert.True(t, ok)
+			assert.Equal(t, []string{"DC", "Philly", "NYC", "Boston", "Portland"}, path)
+
+			_, ok = m.Distance("NYC", "Bermuda")
+			assert.False(t, ok)
+			_, ok = m.Path("NYC", "Bermuda")
+			assert.False(t, ok)
+
+			dist, _ = m.Distance("NYC", "NYC")
+			assert.Equal(t, 0.0, dist)
+			path, _ = m.Path("NYC", "NYC")
+			assert.Equal(t, []string{"NYC"}, path)
+		}
+	})
+
+	// Happy Path
+	t.Run("Negative directed edges are handled by both algorithms", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 3)
+		g.AddEdge("b", "c", -2)
+		g.AddEdge("a", "c", 2)
+		g.AddEdge("c", "d", 1)
+
+		for _, algorithm := range []AllPairsAlgorithm{FloydWarshall, Johnson} {
+			m, err := g.AllPairsShortestPaths(algorithm)
+			assert.NoError(t, err)
+
+			dist, _ := m.Distance("a", "d")
+			assert.Equal(t, 2.0, dist)
+			path, _ := m.Path("a", "d")
+			assert.Equal(t, []string{"a", "b", "c", "d"}, path)
+			_, ok := m.Distance("d", "a")
+			assert.False(t, ok)
+		}
+	})
+
+	// Edge Case
+	t.Run("Equal-cost ties follow NodeOrder on every run", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			for _, algorithm := range []AllPairsAlgorithm{FloydWarshall, Johnson} {
+				m, err := tieGraph().AllPairsShortestPaths(algorithm)
+				assert.NoError(t, err)
+
+				path, _ := m.Path("a", "z")
+				assert.Equal(t, []string{"a", "b", "z"}, path, "algorithm %v", algorithm)
+			}
+		}
+	})
+
+	// Happy Path
+	t.Run("Matches Bellman-Ford from every node on random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(11))
+		g := NewDirectedGraph[int]()
+		for i := 0; i < 25; i++ {
+			g.Insert(Pair[int]{Key: i}, nil)
+		}
+		for i := 0; i < 80; i++ {
+			g.AddEdge(rng.Intn(25), rng.Intn(25), float64(rng.Intn(20)))
+		}
+
+		floyd, err := g.AllPairsShortestPaths(FloydWarshall)
+		assert.NoError(t, err)
+		johnson, err := g.AllPairsShortestPaths(Johnson)
+		assert.NoError(t, err)
+
+		for src := 0; src < 25; src++ {
+			sp, _ := g.BellmanFord(src)
+			for dst := 0; dst < 25; dst++ {
+				want, reachable := sp.Dist[dst]
+				got, ok := floyd.Distance(src, dst)
+				assert.Equal(t, reachable, ok)
+				if reachable {
+					assert.Equal(t, want, got)
+					gotJohnson, _ := johnson.Distance(src, dst)
+					assert.InDelta(t, want, gotJohnson, 1e-9)
+				}
+			}
+		}
+	})
+
+	// Happy Path
+	t.Run("AutoSelect picks by density", func(t *testing.T) {
+		sparse := NewDirectedGraph[int]()
+		for i := 0; i < 100; i++ {
+			sparse.AddEdge(i, i+1, 1)
+		}
+		dense := NewDirectedGraph[int]()
+		for i := 0; i < 10; i++ {
+			for j := 0; j < 10; j++ {
+				dense.AddEdge(i, j, 1)
+			}
+		}
+
+		m, _ := sparse.AllPairsShortestPaths(AutoSelect)
+		assert.Equal(t, Johnson, m.Algorithm)
+		m, _ = dense.AllPairsShortestPaths(AutoSelect)
+		assert.Equal(t, FloydWarshall, m.Algorithm)
+	})
+
+	// Edge Case
+	t.Run("Negative cycles are reported by both algorithms", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", -4)
+		g.AddEdge("c", "b", 2)
+		g.AddEdge("x", "a", 1)
+
+		for _, algorithm := range []AllPairsAlgorithm{FloydWarshall, Johnson} {
+			_, err := g.AllPairsShortestPaths(algorithm)
+
+			var cycleErr *NegativeCycleError[string]
+			assert.True(t, errors.As(err, &cycleErr))
+			assert.Equal(t, []string{"b", "c"}, cycleErr.Cycle)
+		}
+	})
+
+	// Edge Case
+	t.Run("Unknown nodes are unreachable", func(t *testing.T) {
+		m, _ := cityGraph().AllPairsShortestPaths(AutoSelect)
+
+		_, ok := m.Distance("NYC", "Atlantis")
+		assert.False(t, ok)
+		_, ok = m.Path("Atlantis", "NYC")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,161 @@
+package datastructures
+
+import (
+	"fmt"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Heuristic estimates the cost of the cheapest path from node to goal. A*
+// only guarantees the cheapest path when it never overestimates (admissible).
+type Heuristic[T constraints.Ordered] func(node T, goal T) float64
+
+// SearchResult is the outcome of a point-to-point search. Expanded counts the
+// nodes taken off the open set, which is how much of the graph was explored.
+type SearchResult[T constraints.Ordered] struct {
+	Path     []T
+	Cost     float64
+	Expanded int
+}
+
+// Point is a node payload with planar coordinates, for EuclideanHeuristic.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// GeoPoint is a node payload with coordinates in degrees, for HaversineHeuristic.
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+// EarthRadiusKm is the mean radius of the Earth used by HaversineHeuristic.
+const EarthRadiusKm = 6371.0
+
+// nodeValuer is satisfied by Graph and DirectedGraph.
+type nodeValuer[T constraints.Ordered] interface {
+	NodeValue(key T) (any, bool)
+}
+
+// EuclideanHeuristic returns the straight-line distance between the Point
+// payloads of node and goal in g. It is admissible when edge weights are at
+// least the straight-line length of the edge. Nodes without a Point payload
+// estimate 0.
+func EuclideanHeuristic[T constraints.Ordered](g nodeValuer[T]) Heuristic[T] {
+	return func(node T, goal T) float64 {
+		a, okA := payloadAs[T, Point](g, node)
+		b, okB := payloadAs[T, Point](g, goal)
+		if !okA || !okB {
+			return 0
+		}
+		return math.Hypot(a.X-b.X, a.Y-b.Y)
+	}
+}
+
+// HaversineHeuristic returns the great-circle distance in kilometers between
+// the GeoPoint payloads of node and goal in g. It is admissible when edge
+// weights are road distances in kilometers. Nodes without a GeoPoint payload
+// estimate 0.
+func HaversineHeuristic[T constraints.Ordered](g nodeValuer[T]) Heuristic[T] {
+	return func(node T, goal T) float64 {
+		a, okA := payloadAs[T, GeoPoint](g, node)
+		b, okB := payloadAs[T, GeoPoint](g, goal)
+		if !okA || !okB {
+			return 0
+		}
+		return haversine(a, b)
+	}
+}
+
+func haversine(a GeoPoint, b GeoPoint) float64 {
+	toRad := math.Pi / 180
+	dLat := (b.Lat - a.Lat) * toRad
+	dLng := (b.Lng - a.Lng) * toRad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(a.Lat*toRad)*math.Cos(b.Lat*toRad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// payloadAs returns key's payload as a P, accepting either P or *P.
+func payloadAs[T constraints.Ordered, P any](g nodeValuer[T], key T) (P, bool) {
+	var zero P
+	val, ok := g.NodeValue(key)
+	if !ok {
+		return zero, false
+	}
+	switch p := val.(type) {
+	case P:
+		return p, true
+	case *P:
+		if p != nil {
+			return *p, true
+		}
+	}
+	return zero, false
+}
+
+// AStar finds the cheapest path from src to dst, exploring nodes in order of
+// cost so far plus h's estimate of the remaining cost. A nil h makes it
+// Dijkstra's algorithm.
+func (g *Graph[T]) AStar(src T, dst T, h Heuristic[T]) (*SearchResult[T], error) {
+	return g.view().aStar(src, dst, h)
+}
+
+// AStar finds the cheapest path from src to dst along outgoing edges,
+// exploring nodes in order of cost so far plus h's estimate of the remaining
+// cost. A nil h makes it Dijkstra's algorithm.
+func (g *DirectedGraph[T]) AStar(src T, dst T, h Heuristic[T]) (*SearchResult[T], error) {
+	return g.view().aStar(src, dst, h)
+}
+
+func (v graphView[T]) aStar(src T, dst T, h Heuristic[T]) (*SearchResult[T], error) {
+	for _, key := range []T{src, dst} {
+		if _, ok := v.nodes[key]; !ok {
+			return nil, fmt.Errorf("%w: %v", ErrNodeNotFound, key)
+		}
+	}
+	if h == nil {
+		h = func(T, T) float64 { return 0 }
+	}
+
+	sp := &ShortestPaths[T]{Source: src, Dist: map[T]float64{src: 0}, Prev: make(map[T]T)}
+	open := make(map[T]PQHandle[float64, T])
+	pq := NewStablePriorityQueue[float64, T](LowestFirst)
+	open[src] = pq.Enqueue(h(src, dst), src)
+	expanded := 0
+
+	for !pq.Empty() {
+		_, node := pq.Dequeue()
+		delete(open, node)
+		expanded++
+
+		if node == dst {
+			path, _ := sp.PathTo(dst)
+			return &SearchResult[T]{Path: path, Cost: sp.Dist[dst], Expanded: expanded}, nil
+		}
+
+		for _, neighbor := range v.neighborList(node) {
+			weight := v.weights[node][neighbor]
+			if weight < 0 {
+				return nil, fmt.Errorf("%w: %v to %v is %v", ErrNegativeWeight, node, neighbor, weight)
+			}
+			candidate := sp.Dist[node] + weight
+			if best, ok := sp.Dist[neighbor]; ok && best <= candidate {
+				continue
+			}
+			sp.Dist[neighbor] = candidate
+			sp.Prev[neighbor] = node
+			// An admissible but inconsistent heuristic can expand a node before its
+			// cheapest path is known, so a cheaper path puts it back in the open set.
+			priority := candidate + h(neighbor, dst)
+			if handle, ok := open[neighbor]; ok {
+				pq.UpdatePriority(handle, priority)
+			} else {
+				open[neighbor] = pq.Enqueue(priority, neighbor)
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %v to %v", ErrNoPath, src, dst)
+}
@@ -0,0 +1,136 @@
+package datastructures
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gridGraph returns a size x size grid with unit edges whose nodes carry their coordinates as Point payloads.
+func gridGraph(size int) *Graph[string] {
+	g := NewGraph[string]()
+	key := func(x, y int) string { return fmt.Sprintf("%d,%d", x, y) }
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			g.Insert(Pair[string]{Key: key(x, y), Value: Point{X: float64(x), Y: float64(y)}}, nil)
+			if x > 0 {
+				g.AddEdge(key(x-1, y), key(x, y), 1)
+			}
+			if y > 0 {
+				g.AddEdge(key(x, y-1), key(x, y), 1)
+			}
+		}
+	}
+	return g
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for AStar */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_AStar(t *testing.T) {
+
+	// Happy Path
+	t.Run("Euclidean heuristic finds the optimal path with fewer expansions", func(t *testing.T) {
+		g := gridGraph(20)
+
+		guided, err := g.AStar("0,0", "19,0", EuclideanHeuristic[string](g))
+		assert.NoError(t, err)
+		blind, err := g.AStar("0,0", "19,0", nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 19.0, guided.Cost)
+		assert.Equal(t, 19.0, blind.Cost)
+		assert.Len(t, guided.Path, 20)
+		assert.Equal(t, "0,0", guided.Path[0])
+		assert.Equal(t, "19,0", guided.Path[19])
+		assert.Less(t, guided.Expanded, blind.Expanded)
+	})
+
+	// Happy Path
+	t.Run("Matches Dijkstra on a weighted road map", func(t *testing.T) {
+		g := cityGraph()
+
+		result, err := g.AStar("Portland", "DC", nil)
+		assert.NoError(t, err)
+		path, cost, _ := g.ShortestPath("Portland", "DC")
+
+		assert.Equal(t, path, result.Path)
+		assert.Equal(t, cost, result.Cost)
+	})
+
+	// Happy Path
+	t.Run("Haversine heuristic on geographic payloads", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.Insert(Pair[string]{Key: "NYC", Value: GeoPoint{Lat: 40.7128, Lng: -74.0060}}, nil)
+		g.Insert(Pair[string]{Key: "Philly", Value: &GeoPoint{Lat: 39.9526, Lng: -75.1652}}, nil)
+		g.Insert(Pair[string]{Key: "DC", Value: GeoPoint{Lat: 38.9072, Lng: -77.0369}}, nil)
+		g.Insert(Pair[string]{Key: "Boston", Value: GeoPoint{Lat: 42.3601, Lng: -71.0589}}, nil)
+		g.AddEdge("NYC", "Philly", 153)
+		g.AddEdge("Philly", "DC", 225)
+		g.AddEdge("NYC", "Boston", 346)
+		g.AddEdge("Boston", "DC", 710)
+
+		result, err := g.AStar("NYC", "DC", HaversineHeuristic[string](g))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"NYC", "Philly", "DC"}, result.Path)
+		assert.Equal(t, 378.0, result.Cost)
+		assert.Equal(t, 3, result.Expanded)
+	})
+
+	// Edge Case
+	t.Run("Haversine distance is roughly the known value", func(t *testing.T) {
+		nycToLondon := haversine(GeoPoint{Lat: 40.7128, Lng: -74.0060}, GeoPoint{Lat: 51.5074, Lng: -0.1278})
+
+		assert.InDelta(t, 5570, nycToLondon, 10)
+	})
+
+	// Edge Case
+	t.Run("Nodes without coordinates estimate zero", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.Insert(Pair[string]{Key: "a", Value: Point{X: 0, Y: 0}}, nil)
+		g.Insert(Pair[string]{Key: "b", Value: "not a point"}, nil)
+
+		h := EuclideanHeuristic[string](g)
+
+		assert.Equal(t, 0.0, h("a", "b"))
+		assert.Equal(t, 0.0, h("a", "missing"))
+	})
+
+	// Edge Case
+	t.Run("Errors for unknown nodes, unreachable goals and negative weights", func(t *testing.T) {
+		g := cityGraph()
+
+		_, err := g.AStar("NYC", "Atlantis", nil)
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+
+		_, err = g.AStar("NYC", "Bermuda", nil)
+		assert.ErrorIs(t, err, ErrNoPath)
+
+		g.SetWeight("NYC", "Philly", -1)
+		_, err = g.AStar("NYC", "DC", nil)
+		assert.ErrorIs(t, err, ErrNegativeWeight)
+	})
+
+	// Edge Case
+	t.Run("Equal-cost ties follow NodeOrder on every run", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			result, err := tieGraph().AStar("a", "z", nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"a", "b", "z"}, result.Path)
+			assert.Equal(t, 2.0, result.Cost)
+		}
+	})
+
+	// Edge Case
+	t.Run("Search from a node to itself", func(t *testing.T) {
+		result, err := cityGraph().AStar("NYC", "NYC", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"NYC"}, result.Path)
+		assert.Equal(t, 0.0, result.Cost)
+		assert.Equal(t, 1, result.Expanded)
+	})
+}
@@ -0,0 +1,109 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrNegativeCycle = errors.New("graph: negative cycle")
+
+// NegativeCycleError reports a cycle whose weights sum below zero, so no
+// shortest path through it exists. Cycle lists its nodes in edge order,
+// starting from the smallest key; the last node has an edge back to the first.
+type NegativeCycleError[T constraints.Ordered] struct {
+	Cycle []T
+}
+
+func (e *NegativeCycleError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNegativeCycle, e.Cycle)
+}
+
+func (e *NegativeCycleError[T]) Unwrap() error {
+	return ErrNegativeCycle
+}
+
+// BellmanFord returns the distance and predecessor of every node reachable
+// from src along outgoing edges, allowing negative weights. If a negative
+// cycle is reachable from src it returns a *NegativeCycleError holding the
+// cycle. O(n * e)
+func (g *DirectedGraph[T]) BellmanFord(src T) (*ShortestPaths[T], error) {
+	return g.view().bellmanFord(src)
+}
+
+func (v graphView[T]) bellmanFord(src T) (*ShortestPaths[T], error) {
+	if _, ok := v.nodes[src]; !ok {
+		return nil, fmt.Errorf("%w: %v", ErrNodeNotFound, src)
+	}
+	sp := &ShortestPaths[T]{
+		Source: src,
+		Dist:   map[T]float64{src: 0},
+		Prev:   make(map[T]T),
+	}
+
+	// relax lowers every distance it can through one edge and returns a node
+	// whose distance changed, if any. Edges are tried in NodeOrder so the
+	// same negative cycle is reported on every run.
+	nodes, adj := v.orderedAdjacency()
+	relax := func() (T, bool) {
+		var changed T
+		anyChanged := false
+		for _, from := range nodes {
+			dist, ok := sp.Dist[from]
+			if !ok {
+				continue
+			}
+			for _, to := range adj[from] {
+				candidate := dist + v.weights[from][to]
+				if best, ok := sp.Dist[to]; !ok || candidate < best {
+					sp.Dist[to] = candidate
+					sp.Prev[to] = from
+					changed, anyChanged = to, true
+				}
+			}
+		}
+		return changed, anyChanged
+	}
+
+	for i := 1; i < len(v.nodes); i++ {
+		if _, changed := relax(); !changed {
+			return sp, nil
+		}
+	}
+	node, changed := relax()
+	if !changed {
+		return sp, nil
+	}
+	return nil, &NegativeCycleError[T]{Cycle: negativeCycle(sp.Prev, node, len(v.nodes))}
+}
+
+// negativeCycle walks Prev back from a node that was still relaxing after n
+// rounds. After n steps the walk must be inside the cycle; it then follows
+// Prev until it returns to where it started.
+func negativeCycle[T constraints.Ordered](prev map[T]T, node T, n int) []T {
+	for i := 0; i < n; i++ {
+		node = prev[node]
+	}
+	cycle := []T{node}
+	for curr := prev[node]; curr != node; curr = prev[curr] {
+		cycle = append(cycle, curr)
+	}
+	// cycle was collected against edge direction; reverse it and start at the smallest key
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return startAtSmallest(cycle)
+}
+
+// startAtSmallest rotates a cycle listed in edge order so it begins at its
+// smallest key, giving every report of the same cycle the same form.
+func startAtSmallest[T constraints.Ordered](cycle []T) []T {
+	start := 0
+	for i, key := range cycle {
+		if key < cycle[start] {
+			start = i
+		}
+	}
+	return append(append([]T{}, cycle[start:]...), cycle[:start]...)
+}
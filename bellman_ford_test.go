@@ -0,0 +1,104 @@
+package datastructures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for BellmanFord */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDirectedGraph_BellmanFord(t *testing.T) {
+
+	// Happy Path
+	t.Run("Negative edges without a negative cycle", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("base", "promo", 10)
+		g.AddEdge("base", "surge", 4)
+		g.AddEdge("promo", "final", -6)
+		g.AddEdge("surge", "final", 3)
+
+		sp, err := g.BellmanFord("base")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float64{"base": 0, "promo": 10, "surge": 4, "final": 4}, sp.Dist)
+		path, ok := sp.PathTo("final")
+		assert.True(t, ok)
+		assert.Equal(t, 4.0, sp.Dist["final"])
+		assert.Equal(t, "final", path[len(path)-1])
+	})
+
+	// Happy Path
+	t.Run("Matches Dijkstra when every weight is non-negative", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+		g.AddEdge(1, 2, 7)
+		g.AddEdge(1, 3, 9)
+		g.AddEdge(1, 6, 14)
+		g.AddEdge(2, 3, 10)
+		g.AddEdge(2, 4, 15)
+		g.AddEdge(3, 4, 11)
+		g.AddEdge(3, 6, 2)
+		g.AddEdge(4, 5, 6)
+		g.AddEdge(6, 5, 9)
+
+		bellman, err := g.BellmanFord(1)
+		assert.NoError(t, err)
+		dijkstra, err := g.ShortestPathsFrom(1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, dijkstra.Dist, bellman.Dist)
+		assert.Equal(t, 20.0, bellman.Dist[5])
+	})
+
+	// Happy Path
+	t.Run("A reachable negative cycle is reported with its nodes", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("s", "a", 1)
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", -3)
+		g.AddEdge("c", "a", 1)
+		g.AddEdge("c", "t", 1)
+
+		_, err := g.BellmanFord("s")
+
+		assert.ErrorIs(t, err, ErrNegativeCycle)
+		var cycleErr *NegativeCycleError[string]
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.Equal(t, []string{"a", "b", "c"}, cycleErr.Cycle)
+	})
+
+	// Edge Case
+	t.Run("An unreachable negative cycle is ignored", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("s", "t", 2)
+		g.AddEdge("x", "y", -1)
+		g.AddEdge("y", "x", -1)
+
+		sp, err := g.BellmanFord("s")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float64{"s": 0, "t": 2}, sp.Dist)
+	})
+
+	// Edge Case
+	t.Run("A negative self loop is a cycle of one node", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("s", "loop", 1)
+		g.AddEdge("loop", "loop", -1)
+
+		_, err := g.BellmanFord("s")
+
+		var cycleErr *NegativeCycleError[string]
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.Equal(t, []string{"loop"}, cycleErr.Cycle)
+	})
+
+	// Edge Case
+	t.Run("Unknown source", func(t *testing.T) {
+		_, err := NewDirectedGraph[string]().BellmanFord("s")
+
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+}
@@ -0,0 +1,117 @@
+package datastructures
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// ArticulationPoints returns the nodes whose removal would split their
+// connected component. O(n + e)
+func (g *Graph[T]) ArticulationPoints() []T {
+	return g.view().biconnected().points
+}
+
+// Bridges returns the edges whose removal would split their connected
+// component, each with From < To, sorted by From and then To. O(n + e)
+func (g *Graph[T]) Bridges() []Edge[T] {
+	return g.view().biconnected().bridges
+}
+
+// BiconnectedComponents returns the nodes of each maximal piece of the graph
+// that no single node's removal can split. Articulation points belong to
+// several components, a bridge is a component of just its two ends, and a node
+// with no edges is in none. O(n + e)
+func (g *Graph[T]) BiconnectedComponents() [][]T {
+	return g.view().biconnected().components
+}
+
+type biconnectivity[T constraints.Ordered] struct {
+	points     []T
+	bridges    []Edge[T]
+	components [][]T
+}
+
+// biconnected runs Hopcroft and Tarjan's DFS. low[node] is the earliest
+// discovery time reachable from node's DFS subtree using at most one back
+// edge; a child whose low is not earlier than its parent's discovery time
+// cannot get around the parent.
+func (v graphView[T]) biconnected() biconnectivity[T] {
+	disc := make(map[T]int, len(v.nodes))
+	low := make(map[T]int, len(v.nodes))
+	isPoint := make(map[T]struct{})
+	result := biconnectivity[T]{points: []T{}, bridges: []Edge[T]{}, components: [][]T{}}
+	edges := NewStack[Edge[T]]() // edges of the components still being explored
+	frames := NewStack[*dfsFrame[T]]()
+
+	enter := func(visit Visit[T]) {
+		disc[visit.Node] = len(disc)
+		low[visit.Node] = disc[visit.Node]
+		frames.Push(&dfsFrame[T]{visit: visit, neighbors: v.neighborList(visit.Node)})
+	}
+
+	for _, root := range v.nodeList() {
+		if _, ok := disc[root]; ok {
+			continue
+		}
+		rootChildren := 0
+		enter(Visit[T]{Node: root})
+
+		for !frames.Empty() {
+			frame := frames.Top()
+			node := frame.visit.Node
+			if frame.next < len(frame.neighbors) {
+				neighbor := frame.neighbors[frame.next]
+				frame.next++
+				if neighbor == node {
+					continue // a self-loop never connects anything new
+				}
+				if _, ok := disc[neighbor]; !ok {
+					if frame.visit.Depth == 0 {
+						rootChildren++
+					}
+					edges.Push(Edge[T]{From: node, To: neighbor})
+					enter(Visit[T]{Node: neighbor, Parent: node, Depth: frame.visit.Depth + 1})
+				} else if (frame.visit.Depth == 0 || neighbor != frame.visit.Parent) && disc[neighbor] < disc[node] {
+					// a back edge to an ancestor, seen from its lower end only
+					edges.Push(Edge[T]{From: node, To: neighbor})
+					low[node] = min(low[node], disc[neighbor])
+				}
+				continue
+			}
+
+			frames.Pop()
+			if frames.Empty() {
+				break
+			}
+			parent := frames.Top().visit.Node
+			low[parent] = min(low[parent], low[node])
+			if low[node] > disc[parent] {
+				result.bridges = append(result.bridges, undirectedEdge(parent, node, v.weights[parent][node]))
+			}
+			if low[node] >= disc[parent] {
+				// parent separates node's subtree: its edges so far form one component
+				if parent != root || rootChildren > 1 {
+					isPoint[parent] = struct{}{}
+				}
+				members := make(map[T]struct{})
+				for {
+					edge := edges.Top()
+					edges.Pop()
+					members[edge.From] = struct{}{}
+					members[edge.To] = struct{}{}
+					if edge.From == parent && edge.To == node {
+						break
+					}
+				}
+				result.components = append(result.components, v.ordered(members))
+			}
+		}
+	}
+
+	for _, node := range v.nodeList() {
+		if _, ok := isPoint[node]; ok {
+			result.points = append(result.points, node)
+		}
+	}
+	sortEdges(result.bridges)
+	return result
+}
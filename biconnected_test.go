@@ -0,0 +1,145 @@
+package datastructures
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// bowtieGraph is two triangles joined by the bridge 3-4, with a tail 6-7 and an isolated 8.
+func bowtieGraph() *Graph[int] {
+	g := NewOrderedGraph[int](KeyOrder)
+	for _, edge := range [][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 6}, {6, 4}, {6, 7}} {
+		g.AddEdge(edge[0], edge[1], 1)
+	}
+	g.Insert(Pair[int]{Key: 8}, nil)
+	return g
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for ArticulationPoints, Bridges and BiconnectedComponents */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_Biconnectivity(t *testing.T) {
+
+	// Happy Path
+	t.Run("Single points of failure in a bowtie", func(t *testing.T) {
+		g := bowtieGraph()
+
+		assert.Equal(t, []int{3, 4, 6}, g.ArticulationPoints())
+		assert.Equal(t, []Edge[int]{{From: 3, To: 4, Weight: 1}, {From: 6, To: 7, Weight: 1}}, g.Bridges())
+		assert.ElementsMatch(t, [][]int{{1, 2, 3}, {3, 4}, {4, 5, 6}, {6, 7}}, g.BiconnectedComponents())
+	})
+
+	// Happy Path
+	t.Run("A root with two subtrees is an articulation point", func(t *testing.T) {
+		g := NewOrderedGraph[string](KeyOrder)
+		g.AddEdge("hub", "x", 1)
+		g.AddEdge("hub", "y", 1)
+
+		assert.Equal(t, []string{"hub"}, g.ArticulationPoints())
+		assert.Len(t, g.Bridges(), 2)
+	})
+
+	// Happy Path
+	t.Run("Matches removing each node and edge on random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(23))
+		for trial := 0; trial < 20; trial++ {
+			edges := [][2]int{}
+			seen := map[Edge[int]]bool{}
+			for len(edges) < 22 {
+				a, b := rng.Intn(15), rng.Intn(15)
+				if key := undirectedEdge(a, b, 1); !seen[key] {
+					seen[key] = true
+					edges = append(edges, [2]int{a, b})
+				}
+			}
+			build := func(skipNode int, skipEdge int) *Graph[int] {
+				g := NewGraph[int]()
+				for i := 0; i < 15; i++ {
+					if i != skipNode {
+						g.Insert(Pair[int]{Key: i}, nil)
+					}
+				}
+				for i, edge := range edges {
+					if i != skipEdge && edge[0] != skipNode && edge[1] != skipNode {
+						g.AddEdge(edge[0], edge[1], 1)
+					}
+				}
+				return g
+			}
+			g := build(-1, -1)
+			before := len(g.ConnectedComponents())
+
+			wantPoints := []int{}
+			for node := 0; node < 15; node++ {
+				// removing a node drops its own component only if it was isolated
+				after := len(build(node, -1).ConnectedComponents())
+				if len(g.Neighbors(node)) > 0 && after > before {
+					wantPoints = append(wantPoints, node)
+				}
+			}
+			wantBridges := map[Edge[int]]struct{}{}
+			for i, edge := range edges {
+				if edge[0] != edge[1] && len(build(-1, i).ConnectedComponents()) > before {
+					wantBridges[undirectedEdge(edge[0], edge[1], 1)] = struct{}{}
+				}
+			}
+
+			assert.ElementsMatch(t, wantPoints, g.ArticulationPoints())
+			gotBridges := map[Edge[int]]struct{}{}
+			for _, bridge := range g.Bridges() {
+				gotBridges[bridge] = struct{}{}
+			}
+			assert.Equal(t, wantBridges, gotBridges)
+
+			// two components share at most one node, so each edge lies in exactly one
+			edgesInComponents := 0
+			for _, component := range g.BiconnectedComponents() {
+				for _, a := range component {
+					for _, b := range component {
+						if _, ok := g.Weight(a, b); ok && a < b {
+							edgesInComponents++
+						}
+					}
+				}
+			}
+			edgeCount := 0
+			for _, node := range g.Nodes() {
+				for _, neighbor := range g.Neighbors(node) {
+					if node < neighbor {
+						edgeCount++
+					}
+				}
+			}
+			assert.Equal(t, edgeCount, edgesInComponents)
+		}
+	})
+
+	// Edge Case
+	t.Run("A long path is handled without recursion", func(t *testing.T) {
+		g := NewGraph[int]()
+		for i := 1; i < 100000; i++ {
+			g.AddEdge(i-1, i, 1)
+		}
+
+		assert.Len(t, g.ArticulationPoints(), 99998)
+		assert.Len(t, g.Bridges(), 99999)
+	})
+
+	// Edge Case
+	t.Run("Cycles, self-loops and isolated nodes have no failure points", func(t *testing.T) {
+		g := NewGraph[int]()
+		for i := 0; i < 5; i++ {
+			g.AddEdge(i, (i+1)%5, 1)
+		}
+		g.AddEdge(2, 2, 1)
+		g.Insert(Pair[int]{Key: 9}, nil)
+
+		assert.Empty(t, g.ArticulationPoints())
+		assert.Empty(t, g.Bridges())
+		components := g.BiconnectedComponents()
+		assert.Len(t, components, 1)
+		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4}, components[0])
+	})
+}
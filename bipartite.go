@@ -0,0 +1,319 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	ErrNotBipartite = errors.New("graph: not bipartite")
+	ErrNoAssignment = errors.New("graph: no assignment covers the smaller side")
+)
+
+// Matching is a set of edges no two of which share a node.
+type Matching[T constraints.Ordered] struct {
+	Pairs []Edge[T] // From is on the left side and To on the right, in the order of the left side
+	Cost  float64   // sum of the matched edges' weights
+	mate  map[T]T
+}
+
+// Partner returns the node matched with node and whether node is matched.
+func (m *Matching[T]) Partner(node T) (T, bool) {
+	mate, ok := m.mate[node]
+	return mate, ok
+}
+
+// Bipartition splits the nodes into two sides so that every edge joins the
+// two, putting the first node of each component (in the graph's NodeOrder) on
+// the left. It returns ErrNotBipartite if an odd cycle makes that impossible. O(n + e)
+func (g *Graph[T]) Bipartition() (left []T, right []T, err error) {
+	return g.view().bipartition()
+}
+
+// MaximumMatching returns a matching with as many edges as possible, using
+// Hopcroft-Karp. left names one side of the graph; nil uses the left side of
+// Bipartition. It returns ErrNotBipartite if an edge does not join left to the
+// other nodes. O(e * sqrt(n))
+func (g *Graph[T]) MaximumMatching(left []T) (*Matching[T], error) {
+	v := g.view()
+	left, _, err := v.sides(left)
+	if err != nil {
+		return nil, err
+	}
+	return v.matching(left, v.hopcroftKarp(left)), nil
+}
+
+// MinCostAssignment returns the matching of least total weight among those
+// that match every node on the smaller side, using the Hungarian algorithm.
+// left names one side of the graph; nil uses the left side of Bipartition.
+// A missing edge means that pair cannot be matched; if no such matching
+// exists it returns ErrNoAssignment. O(n^3)
+func (g *Graph[T]) MinCostAssignment(left []T) (*Matching[T], error) {
+	v := g.view()
+	left, right, err := v.sides(left)
+	if err != nil {
+		return nil, err
+	}
+	// the Hungarian algorithm assigns every row, so the smaller side must be the rows
+	swapped := len(left) > len(right)
+	rows, cols := left, right
+	if swapped {
+		rows, cols = right, left
+	}
+	assigned, err := v.hungarian(rows, cols)
+	if err != nil {
+		return nil, err
+	}
+	mateOfLeft := make(map[T]T, len(assigned))
+	for row, col := range assigned {
+		if swapped {
+			mateOfLeft[col] = row
+		} else {
+			mateOfLeft[row] = col
+		}
+	}
+	return v.matching(left, mateOfLeft), nil
+}
+
+func (v graphView[T]) bipartition() ([]T, []T, error) {
+	onLeft := make(map[T]bool, len(v.nodes))
+	for _, root := range v.nodeList() {
+		if _, ok := onLeft[root]; ok {
+			continue
+		}
+		onLeft[root] = true
+		queue := NewQueue[T]()
+		queue.Enqueue(root)
+		for !queue.Empty() {
+			node := queue.Front()
+			queue.Dequeue()
+			for _, neighbor := range v.neighborList(node) {
+				side, ok := onLeft[neighbor]
+				if !ok {
+					onLeft[neighbor] = !onLeft[node]
+					queue.Enqueue(neighbor)
+				} else if side == onLeft[node] {
+					return nil, nil, fmt.Errorf("%w: %v and %v are on the same side", ErrNotBipartite, node, neighbor)
+				}
+			}
+		}
+	}
+	left, right := []T{}, []T{}
+	for _, node := range v.nodeList() {
+		if onLeft[node] {
+			left = append(left, node)
+		} else {
+			right = append(right, node)
+		}
+	}
+	return left, right, nil
+}
+
+// sides checks that left is one side of the graph and returns it with the
+// other side, or both sides of bipartition when left is nil.
+func (v graphView[T]) sides(left []T) ([]T, []T, error) {
+	if left == nil {
+		return v.bipartition()
+	}
+	onLeft := make(map[T]struct{}, len(left))
+	for _, node := range left {
+		if _, ok := v.nodes[node]; !ok {
+			return nil, nil, fmt.Errorf("%w: %v", ErrNodeNotFound, node)
+		}
+		onLeft[node] = struct{}{}
+	}
+	right := []T{}
+	for _, node := range v.nodeList() {
+		_, nodeOnLeft := onLeft[node]
+		if !nodeOnLeft {
+			right = append(right, node)
+		}
+		for _, neighbor := range v.neighborList(node) {
+			if _, neighborOnLeft := onLeft[neighbor]; nodeOnLeft == neighborOnLeft {
+				return nil, nil, fmt.Errorf("%w: %v and %v are on the same side", ErrNotBipartite, node, neighbor)
+			}
+		}
+	}
+	return left, right, nil
+}
+
+// matching builds a Matching from each matched left node's partner.
+func (v graphView[T]) matching(left []T, mateOfLeft map[T]T) *Matching[T] {
+	m := &Matching[T]{Pairs: []Edge[T]{}, mate: make(map[T]T, 2*len(mateOfLeft))}
+	for _, node := range left {
+		mate, ok := mateOfLeft[node]
+		if !ok {
+			continue
+		}
+		weight := v.weights[node][mate]
+		m.Pairs = append(m.Pairs, Edge[T]{From: node, To: mate, Weight: weight})
+		m.Cost += weight
+		m.mate[node] = mate
+		m.mate[mate] = node
+	}
+	return m
+}
+
+// hkFrame is a left node on the augmenting-path search and the neighbors it has left to try.
+type hkFrame[T constraints.Ordered] struct {
+	node      T
+	neighbors []T
+	next      int
+}
+
+// hopcroftKarp grows the matching in phases. Each phase layers the graph by
+// BFS from the unmatched left nodes, then augments along as many shortest
+// vertex-disjoint alternating paths as it can find.
+func (v graphView[T]) hopcroftKarp(left []T) map[T]T {
+	mateOfLeft := make(map[T]T, len(left))
+	mateOfRight := make(map[T]T, len(left))
+
+	for {
+		// dist[u] is the phase's layer of left node u; nodes without one are skipped
+		dist := make(map[T]int, len(left))
+		queue := NewQueue[T]()
+		for _, node := range left {
+			if _, ok := mateOfLeft[node]; !ok {
+				dist[node] = 0
+				queue.Enqueue(node)
+			}
+		}
+		// limit is the layer where a free right node is first reached, the
+		// length of the shortest augmenting paths; layers past it are dropped.
+		limit := -1
+		for !queue.Empty() {
+			node := queue.Front()
+			queue.Dequeue()
+			if limit >= 0 && dist[node] > limit {
+				delete(dist, node)
+				continue
+			}
+			for _, neighbor := range v.neighborList(node) {
+				mate, ok := mateOfRight[neighbor]
+				if !ok {
+					if limit < 0 {
+						limit = dist[node]
+					}
+				} else if _, ok := dist[mate]; !ok {
+					dist[mate] = dist[node] + 1
+					queue.Enqueue(mate)
+				}
+			}
+		}
+		if limit < 0 {
+			return mateOfLeft
+		}
+
+		for _, root := range left {
+			if _, ok := mateOfLeft[root]; ok {
+				continue
+			}
+			// path[i] chose path[i].neighbors[path[i].next-1] to reach path[i+1].
+			path := []*hkFrame[T]{{node: root, neighbors: v.neighborList(root)}}
+			for len(path) > 0 {
+				frame := path[len(path)-1]
+				if frame.next == len(frame.neighbors) {
+					delete(dist, frame.node) // a dead end for the rest of the phase
+					path = path[:len(path)-1]
+					continue
+				}
+				neighbor := frame.neighbors[frame.next]
+				frame.next++
+				mate, ok := mateOfRight[neighbor]
+				if !ok && dist[frame.node] == limit {
+					// neighbor is free: flip every edge along the path
+					for _, step := range path {
+						chosen := step.neighbors[step.next-1]
+						mateOfLeft[step.node] = chosen
+						mateOfRight[chosen] = step.node
+					}
+					break
+				}
+				if !ok {
+					continue // free, but reaching it here would not be a shortest path
+				}
+				if depth, ok := dist[mate]; ok && depth == dist[frame.node]+1 {
+					path = append(path, &hkFrame[T]{node: mate, neighbors: v.neighborList(mate)})
+				}
+			}
+		}
+	}
+}
+
+// hungarian assigns every row a distinct column at least total weight and
+// returns each row's column. It keeps a potential for every row and column so
+// that each row joins the assignment along a shortest path of reduced costs;
+// len(rows) must not exceed len(cols).
+func (v graphView[T]) hungarian(rows []T, cols []T) (map[T]T, error) {
+	n, m := len(rows), len(cols)
+	cost := func(i int, j int) float64 {
+		if weight, ok := v.weights[rows[i-1]][cols[j-1]]; ok {
+			return weight
+		}
+		return math.Inf(1)
+	}
+	// indices are 1-based; column 0 is a virtual column that holds the row being added
+	u := make([]float64, n+1)
+	p := make([]float64, m+1)
+	owner := make([]int, m+1) // owner[j] is the row assigned to column j, or 0
+	way := make([]int, m+1)   // way[j] is the column before j on the current shortest path
+
+	for i := 1; i <= n; i++ {
+		owner[0] = i
+		col := 0
+		minv := make([]float64, m+1)
+		used := make([]bool, m+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+		for {
+			used[col] = true
+			row := owner[col]
+			delta, next := math.Inf(1), 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if reduced := cost(row, j) - u[row] - p[j]; reduced < minv[j] {
+					minv[j] = reduced
+					way[j] = col
+				}
+				if minv[j] < delta {
+					delta, next = minv[j], j
+				}
+			}
+			if math.IsInf(delta, 1) {
+				return nil, fmt.Errorf("%w: %v cannot be matched", ErrNoAssignment, rows[i-1])
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[owner[j]] += delta
+					p[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			col = next
+			if owner[col] == 0 {
+				break
+			}
+		}
+		// shift assignments back along the path to free column 0
+		for col != 0 {
+			prev := way[col]
+			owner[col] = owner[prev]
+			col = prev
+		}
+	}
+
+	assigned := make(map[T]T, n)
+	for j := 1; j <= m; j++ {
+		if owner[j] != 0 {
+			assigned[rows[owner[j]-1]] = cols[j-1]
+		}
+	}
+	return assigned, nil
+}
@@ -0,0 +1,247 @@
+package datastructures
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assignmentGraph joins worker Wi to job Jj with weight costs[i][j]; a
+// negative cost leaves the pair unconnected.
+func assignmentGraph(costs [][]float64) (*Graph[string], []string) {
+	g := NewOrderedGraph[string](InsertionOrder)
+	workers := []string{}
+	for i, row := range costs {
+		worker := fmt.Sprintf("W%d", i+1)
+		workers = append(workers, worker)
+		g.Insert(Pair[string]{Key: worker}, nil)
+		for j, cost := range row {
+			if cost >= 0 {
+				g.AddEdge(worker, fmt.Sprintf("J%d", j+1), cost)
+			}
+		}
+	}
+	return g, workers
+}
+
+// bruteForceAssignment tries every way to give each worker a distinct job.
+func bruteForceAssignment(costs [][]float64) float64 {
+	best := math.Inf(1)
+	taken := make([]bool, len(costs[0]))
+	var try func(worker int, total float64)
+	try = func(worker int, total float64) {
+		if worker == len(costs) {
+			best = math.Min(best, total)
+			return
+		}
+		for job, cost := range costs[worker] {
+			if !taken[job] && cost >= 0 {
+				taken[job] = true
+				try(worker+1, total+cost)
+				taken[job] = false
+			}
+		}
+	}
+	try(0, 0)
+	return best
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Bipartition */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_Bipartition(t *testing.T) {
+
+	// Happy Path
+	t.Run("An even cycle splits in two", func(t *testing.T) {
+		g := NewOrderedGraph[int](KeyOrder)
+		for i := 0; i < 6; i++ {
+			g.AddEdge(i, (i+1)%6, 1)
+		}
+		g.Insert(Pair[int]{Key: 9}, nil)
+
+		left, right, err := g.Bipartition()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 2, 4, 9}, left)
+		assert.Equal(t, []int{1, 3, 5}, right)
+	})
+
+	// Edge Case
+	t.Run("An odd cycle cannot be split", func(t *testing.T) {
+		g := NewGraph[int]()
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(2, 3, 1)
+		g.AddEdge(3, 1, 1)
+
+		_, _, err := g.Bipartition()
+
+		assert.ErrorIs(t, err, ErrNotBipartite)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for MaximumMatching */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_MaximumMatching(t *testing.T) {
+
+	// Happy Path
+	t.Run("Drivers are matched to riders", func(t *testing.T) {
+		g := NewOrderedGraph[string](KeyOrder)
+		g.Insert(Pair[string]{Key: "driver-a"}, []string{"rider-1", "rider-2"})
+		g.Insert(Pair[string]{Key: "driver-b"}, []string{"rider-1"})
+		g.Insert(Pair[string]{Key: "driver-c"}, []string{"rider-2", "rider-3"})
+		g.Insert(Pair[string]{Key: "driver-d"}, []string{"rider-3", "rider-4"})
+
+		m, err := g.MaximumMatching([]string{"driver-a", "driver-b", "driver-c", "driver-d"})
+
+		assert.NoError(t, err)
+		// driver-b can only take rider-1, which forces every other pair
+		assert.Equal(t, []Edge[string]{
+			{From: "driver-a", To: "rider-2", Weight: 1},
+			{From: "driver-b", To: "rider-1", Weight: 1},
+			{From: "driver-c", To: "rider-3", Weight: 1},
+			{From: "driver-d", To: "rider-4", Weight: 1},
+		}, m.Pairs)
+		partner, ok := m.Partner("driver-b")
+		assert.True(t, ok)
+		assert.Equal(t, "rider-1", partner)
+		partner, _ = m.Partner("rider-1")
+		assert.Equal(t, "driver-b", partner)
+	})
+
+	// Happy Path
+	t.Run("Matches the max flow on random bipartite graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(22))
+		for trial := 0; trial < 30; trial++ {
+			g := NewGraph[int]()
+			network := NewFlowNetwork[int]()
+			left := []int{}
+			for i := 0; i < 15; i++ {
+				left = append(left, i)
+				g.Insert(Pair[int]{Key: i}, nil)
+				network.AddEdge(-1, i, 1)
+			}
+			for i := 100; i < 115; i++ {
+				network.AddEdge(i, -2, 1)
+			}
+			for i := 0; i < 35; i++ {
+				from, to := rng.Intn(15), 100+rng.Intn(15)
+				g.AddEdge(from, to, 1)
+				network.AddEdge(from, to, 1)
+			}
+
+			m, err := g.MaximumMatching(left)
+			assert.NoError(t, err)
+			f, _ := network.MaxFlow(-1, -2, Dinic)
+
+			assert.Equal(t, int(f.Value), len(m.Pairs))
+			used := map[int]bool{}
+			for _, pair := range m.Pairs {
+				assert.False(t, used[pair.To], "right node %d matched twice", pair.To)
+				used[pair.To] = true
+				_, ok := g.Weight(pair.From, pair.To)
+				assert.True(t, ok)
+			}
+		}
+	})
+
+	// Edge Case
+	t.Run("A left side with an edge inside it is rejected", func(t *testing.T) {
+		g := NewGraph[int]()
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(1, 3, 1)
+
+		_, err := g.MaximumMatching([]int{1, 2})
+		assert.ErrorIs(t, err, ErrNotBipartite)
+		_, err = g.MaximumMatching([]int{7})
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for MinCostAssignment */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_MinCostAssignment(t *testing.T) {
+
+	// Happy Path
+	t.Run("Textbook four by four assignment", func(t *testing.T) {
+		g, workers := assignmentGraph([][]float64{
+			{9, 2, 7, 8},
+			{6, 4, 3, 7},
+			{5, 8, 1, 8},
+			{7, 6, 9, 4},
+		})
+
+		m, err := g.MinCostAssignment(workers)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 13.0, m.Cost)
+		assert.Equal(t, []Edge[string]{
+			{From: "W1", To: "J2", Weight: 2},
+			{From: "W2", To: "J1", Weight: 6},
+			{From: "W3", To: "J3", Weight: 1},
+			{From: "W4", To: "J4", Weight: 4},
+		}, m.Pairs)
+	})
+
+	// Happy Path
+	t.Run("The smaller side is fully assigned whichever side is left", func(t *testing.T) {
+		g, workers := assignmentGraph([][]float64{
+			{4, 1, 3},
+			{2, 0, 5},
+		})
+
+		byWorkers, err := g.MinCostAssignment(workers)
+		assert.NoError(t, err)
+		byJobs, err := g.MinCostAssignment([]string{"J1", "J2", "J3"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 3.0, byWorkers.Cost)
+		assert.Equal(t, 3.0, byJobs.Cost)
+		assert.Len(t, byJobs.Pairs, 2)
+		job, _ := byJobs.Partner("W1")
+		assert.Equal(t, "J2", job)
+	})
+
+	// Happy Path
+	t.Run("Matches brute force on random costs with missing pairs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(4))
+		for trial := 0; trial < 40; trial++ {
+			costs := make([][]float64, 1+rng.Intn(5))
+			jobs := len(costs) + rng.Intn(3)
+			for i := range costs {
+				costs[i] = make([]float64, jobs)
+				for j := range costs[i] {
+					costs[i][j] = float64(rng.Intn(12) - 2) // -1 and -2 mean no edge
+				}
+			}
+			g, workers := assignmentGraph(costs)
+
+			m, err := g.MinCostAssignment(workers)
+
+			want := bruteForceAssignment(costs)
+			if math.IsInf(want, 1) {
+				assert.ErrorIs(t, err, ErrNoAssignment)
+				continue
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, want, m.Cost)
+			assert.Len(t, m.Pairs, len(costs))
+		}
+	})
+
+	// Edge Case
+	t.Run("A worker with no possible job", func(t *testing.T) {
+		g, workers := assignmentGraph([][]float64{
+			{1, 2},
+			{-1, -1},
+		})
+
+		_, err := g.MinCostAssignment(workers)
+
+		assert.ErrorIs(t, err, ErrNoAssignment)
+	})
+}
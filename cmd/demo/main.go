@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	ds "github.com/runquan-ray-zhou/go-data-structures"
+)
+
+func main() {
+	fmt.Println("Hello World")
+
+	graph := ds.NewGraph[string]()
+
+	graph.Insert(ds.Pair[string]{Key: "Alice", Value: nil}, []string{"Bob", "Candy", "Derek", "Elaine"})
+	graph.Insert(ds.Pair[string]{Key: "Bob", Value: nil}, []string{"Fred", "Alice"})
+	graph.Insert(ds.Pair[string]{Key: "Fred", Value: nil}, []string{"Bob", "Helen"})
+	graph.Insert(ds.Pair[string]{Key: "Helen", Value: nil}, []string{"Fred", "Candy"})
+	graph.Insert(ds.Pair[string]{Key: "Candy", Value: nil}, []string{"Alice", "Helen"})
+	graph.Insert(ds.Pair[string]{Key: "Derek", Value: nil}, []string{"Alice", "Elaine", "Gina"})
+	graph.Insert(ds.Pair[string]{Key: "Gina", Value: nil}, []string{"Derek", "Irena"})
+	graph.Insert(ds.Pair[string]{Key: "Irena", Value: nil}, []string{"Gina"})
+	graph.Insert(ds.Pair[string]{Key: "Elaine", Value: nil}, []string{"Alice", "Derek"})
+
+	/*
+			graph.nodes
+			map[string]struct{}{
+				"Alice":  struct{}{},
+				"Bob":    struct{}{},
+				"Candy":  struct{}{},
+				"Derek":  struct{}{},
+				"Elaine": struct{}{},
+				"Fred":   struct{}{},
+				"Gina":   struct{}{},
+				"Helen":  struct{}{},
+				"Irena":  struct{}{},
+			}
+
+			graph.neighbors
+			map[string]map[string]struct{}{
+		    "Alice": {
+		        "Bob":    struct{}{},
+		        "Candy":  struct{}{},
+		        "Derek":  struct{}{},
+		        "Elaine": struct{}{},
+		    },
+		    "Bob": {
+		        "Fred":   struct{}{},
+		        "Alice":  struct{}{},
+		    },
+		    "Fred": {
+		        "Bob":    struct{}{},
+		        "Helen":  struct{}{},
+		    },
+		    "Helen": {
+		        "Fred":   struct{}{},
+		        "Candy":  struct{}{},
+		    },
+		    "Candy": {
+		        "Alice":  struct{}{},
+		        "Helen":  struct{}{},
+		    },
+		    "Derek": {
+		        "Alice":  struct{}{},
+		        "Elaine": struct{}{},
+		        "Gina":   struct{}{},
+		    },
+		    "Elaine": {
+		        "Alice":  struct{}{},
+		        "Derek":  struct{}{},
+		    },
+		    "Gina": {
+		        "Derek":  struct{}{},
+		        "Irena":  struct{}{},
+		    },
+		    "Irena": {
+		        "Gina":   struct{}{},
+		    },
+		}
+	*/
+
+	fmt.Println(graph.Size())
+	fmt.Println(graph.Empty())
+
+	visited := graph.DepthFirstTraversal()
+	fmt.Println(visited)
+
+	result := graph.BreadthFirstTraversal()
+	fmt.Println(result)
+
+	if err := graph.WriteDOT(os.Stdout); err != nil {
+		fmt.Println(err)
+	}
+
+	list := ds.NewSinglyLinkedList[int]()
+	list.InsertAtFront(3)
+	list.InsertAfter(4, list.Head())
+	fmt.Println(list.Size())
+	fmt.Println(list.Head().Data)
+
+	stack := ds.NewStack[int]()
+	stack.Push(3)
+	stack.Push(4)
+	stack.Pop()
+	fmt.Println(stack.Top())
+	fmt.Println(stack.Size())
+
+	queue := ds.NewQueue[string]()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Dequeue()
+	fmt.Println(queue.Front())
+
+	tree := ds.NewBinarySearchTree[int]()
+	for _, key := range []int{5, 3, 8, 1, 4} {
+		tree.Insert(key, nil)
+	}
+	fmt.Println(tree.InOrderTraversal())
+}
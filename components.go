@@ -0,0 +1,163 @@
+package datastructures
+
+// ConnectedComponents returns the nodes of each connected component. With
+// KeyOrder or InsertionOrder, components come in the order of their first node
+// and each lists its nodes in that order. O(n + e)
+func (g *Graph[T]) ConnectedComponents() [][]T {
+	v := g.view()
+	seen := make(map[T]struct{}, len(v.nodes))
+	components := [][]T{}
+	for _, root := range v.nodeList() {
+		if _, ok := seen[root]; ok {
+			continue
+		}
+		visits, _ := v.bfsFrom(root, nil)
+		component := make([]T, 0, len(visits))
+		for _, visit := range visits {
+			seen[visit.Node] = struct{}{}
+			component = append(component, visit.Node)
+		}
+		components = append(components, v.sortNodes(component))
+	}
+	return components
+}
+
+// StronglyConnectedComponents returns the groups of nodes that can all reach
+// one another, using Tarjan's algorithm. Components come in topological order
+// of the Condensation: no edge leads from a component to an earlier one. O(n + e)
+func (g *DirectedGraph[T]) StronglyConnectedComponents() [][]T {
+	return g.view().tarjan()
+}
+
+// StronglyConnectedComponentsKosaraju returns the same components as
+// StronglyConnectedComponents, in the same order, using Kosaraju's two-pass
+// algorithm. O(n + e)
+func (g *DirectedGraph[T]) StronglyConnectedComponentsKosaraju() [][]T {
+	reversed := g.view()
+	reversed.adj = g.in
+	assigned := make(map[T]struct{}, len(g.nodes))
+	components := [][]T{}
+
+	// The unassigned node that finished last lies in a source component of what
+	// is left, and walking reversed edges from it cannot leave that component.
+	finish := g.view().depthFirstOrders().PostOrder
+	for i := len(finish) - 1; i >= 0; i-- {
+		if _, ok := assigned[finish[i]]; ok {
+			continue
+		}
+		component := []T{}
+		stack := NewStack[T]()
+		stack.Push(finish[i])
+		assigned[finish[i]] = struct{}{}
+		for !stack.Empty() {
+			node := stack.Top()
+			stack.Pop()
+			component = append(component, node)
+			for _, neighbor := range reversed.neighborList(node) {
+				if _, ok := assigned[neighbor]; !ok {
+					assigned[neighbor] = struct{}{}
+					stack.Push(neighbor)
+				}
+			}
+		}
+		components = append(components, reversed.sortNodes(component))
+	}
+	return components
+}
+
+// Condensation collapses each strongly connected component to a single node,
+// which always leaves a DAG. Node i of the returned graph is component i of
+// StronglyConnectedComponents and holds that component's nodes as its
+// NodeValue; componentOf maps every original node to its component. An edge
+// i -> j carries the smallest weight among the original edges it replaces. O(n + e)
+func (g *DirectedGraph[T]) Condensation() (dag *DirectedGraph[int], componentOf map[T]int) {
+	components := g.StronglyConnectedComponents()
+	dag = NewOrderedDirectedGraph[int](KeyOrder)
+	componentOf = make(map[T]int, len(g.nodes))
+	for i, component := range components {
+		dag.Insert(Pair[int]{Key: i, Value: component}, nil)
+		for _, node := range component {
+			componentOf[node] = i
+		}
+	}
+	for from, neighbors := range g.out {
+		for to := range neighbors {
+			i, j := componentOf[from], componentOf[to]
+			if i == j {
+				continue
+			}
+			if weight, ok := dag.Weight(i, j); !ok || g.weights[from][to] < weight {
+				dag.AddEdge(i, j, g.weights[from][to])
+			}
+		}
+	}
+	return dag, componentOf
+}
+
+// tarjan finds components in one DFS. low[node] is the smallest index reachable
+// from node's subtree through at most one edge back into the stack; a node whose
+// low equals its own index is the root of a component made of everything above
+// it on the stack.
+func (v graphView[T]) tarjan() [][]T {
+	index := make(map[T]int, len(v.nodes))
+	low := make(map[T]int, len(v.nodes))
+	onStack := make(map[T]struct{})
+	pending := NewStack[T]()
+	components := [][]T{}
+	frames := NewStack[*dfsFrame[T]]()
+
+	enter := func(node T) {
+		index[node] = len(index)
+		low[node] = index[node]
+		pending.Push(node)
+		onStack[node] = struct{}{}
+		frames.Push(&dfsFrame[T]{visit: Visit[T]{Node: node}, neighbors: v.neighborList(node)})
+	}
+
+	for _, root := range v.nodeList() {
+		if _, ok := index[root]; ok {
+			continue
+		}
+		enter(root)
+		for !frames.Empty() {
+			frame := frames.Top()
+			node := frame.visit.Node
+			if frame.next < len(frame.neighbors) {
+				neighbor := frame.neighbors[frame.next]
+				frame.next++
+				if _, ok := index[neighbor]; !ok {
+					enter(neighbor)
+				} else if _, ok := onStack[neighbor]; ok {
+					low[node] = min(low[node], index[neighbor])
+				}
+				continue
+			}
+
+			frames.Pop()
+			if !frames.Empty() {
+				parent := frames.Top().visit.Node
+				low[parent] = min(low[parent], low[node])
+			}
+			if low[node] != index[node] {
+				continue
+			}
+			component := []T{}
+			for {
+				member := pending.Top()
+				pending.Pop()
+				delete(onStack, member)
+				component = append(component, member)
+				if member == node {
+					break
+				}
+			}
+			components = append(components, v.sortNodes(component))
+		}
+	}
+
+	// Tarjan completes sink components first; reverse into topological order.
+	for i, j := 0, len(components)-1; i < j; i, j = i+1, j-1 {
+		components[i], components[j] = components[j], components[i]
+	}
+	return components
+}
@@ -0,0 +1,121 @@
+package datastructures
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clrsGraph is the strongly connected components example from CLRS.
+func clrsGraph() *DirectedGraph[string] {
+	g := NewOrderedDirectedGraph[string](KeyOrder)
+	g.Insert(Pair[string]{Key: "a"}, []string{"b"})
+	g.Insert(Pair[string]{Key: "b"}, []string{"c", "e"})
+	g.Insert(Pair[string]{Key: "c"}, []string{"d", "g"})
+	g.Insert(Pair[string]{Key: "d"}, []string{"c", "h"})
+	g.Insert(Pair[string]{Key: "e"}, []string{"a"})
+	g.Insert(Pair[string]{Key: "f"}, []string{"g"})
+	g.Insert(Pair[string]{Key: "g"}, []string{"f", "h"})
+	g.Insert(Pair[string]{Key: "h"}, []string{"h"})
+	g.AddEdge("b", "f", 5)
+	g.AddEdge("e", "f", 2)
+	return g
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for ConnectedComponents */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_ConnectedComponents(t *testing.T) {
+
+	// Happy Path
+	t.Run("Groups each component's nodes", func(t *testing.T) {
+		components := orderedFriendsGraph(KeyOrder).ConnectedComponents()
+
+		assert.Equal(t, [][]string{
+			{"Alice", "Bob", "Candy", "Derek", "Elaine", "Fred", "Gina", "Helen", "Irena"},
+			{"Yara", "Zed"},
+		}, components)
+	})
+
+	// Edge Case
+	t.Run("Isolated nodes are components of one", func(t *testing.T) {
+		g := NewOrderedGraph[int](InsertionOrder)
+		g.Insert(Pair[int]{Key: 3}, nil)
+		g.AddEdge(2, 1, 1)
+
+		assert.Equal(t, [][]int{{3}, {2, 1}}, g.ConnectedComponents())
+		assert.Empty(t, NewGraph[int]().ConnectedComponents())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for StronglyConnectedComponents and Condensation */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDirectedGraph_StronglyConnectedComponents(t *testing.T) {
+
+	// Happy Path
+	t.Run("Tarjan and Kosaraju find the textbook components in topological order", func(t *testing.T) {
+		want := [][]string{{"a", "b", "e"}, {"c", "d"}, {"f", "g"}, {"h"}}
+
+		assert.Equal(t, want, clrsGraph().StronglyConnectedComponents())
+		assert.Equal(t, want, clrsGraph().StronglyConnectedComponentsKosaraju())
+	})
+
+	// Happy Path
+	t.Run("Both algorithms agree on random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(5))
+		for trial := 0; trial < 20; trial++ {
+			g := NewOrderedDirectedGraph[int](KeyOrder)
+			for i := 0; i < 30; i++ {
+				g.AddEdge(rng.Intn(30), rng.Intn(30), 1)
+			}
+
+			tarjan := g.StronglyConnectedComponents()
+			kosaraju := g.StronglyConnectedComponentsKosaraju()
+
+			assert.ElementsMatch(t, tarjan, kosaraju)
+			dag, componentOf := g.Condensation()
+			assert.False(t, dag.HasCycle())
+			for from, neighbors := range g.out {
+				for to := range neighbors {
+					// topological order: edges never point to an earlier component
+					assert.LessOrEqual(t, componentOf[from], componentOf[to])
+				}
+			}
+		}
+	})
+
+	// Happy Path
+	t.Run("Condensation is the DAG of components", func(t *testing.T) {
+		dag, componentOf := clrsGraph().Condensation()
+
+		assert.Equal(t, 4, dag.Size())
+		assert.Equal(t, 1, componentOf["d"])
+		members, _ := dag.NodeValue(0)
+		assert.Equal(t, []string{"a", "b", "e"}, members)
+		assert.Equal(t, []int{1, 2}, dag.OutNeighbors(0))
+		assert.Equal(t, []int{2, 3}, dag.OutNeighbors(1))
+		assert.Equal(t, []int{3}, dag.OutNeighbors(2))
+		assert.Empty(t, dag.OutNeighbors(3))
+
+		weight, _ := dag.Weight(0, 2)
+		assert.Equal(t, 2.0, weight)
+	})
+
+	// Edge Case
+	t.Run("A DAG has one component per node", func(t *testing.T) {
+		g := jobGraph()
+
+		components := g.StronglyConnectedComponents()
+
+		assert.Len(t, components, g.Size())
+		order, _ := g.TopologicalSort()
+		flattened := []string{}
+		for _, component := range components {
+			flattened = append(flattened, component...)
+		}
+		assertTopological(t, g, flattened)
+		assert.ElementsMatch(t, order, flattened)
+	})
+}
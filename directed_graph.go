@@ -0,0 +1,197 @@
+package datastructures
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+type DirectedGraphInterface[T constraints.Ordered] interface {
+	Insert(val Pair[T], neighbors []T)           // helper function to build/update graph. inserts T into graph if not present, stores val.Value, adds edges from T to each neighbor with weight 1. O(neighbors)
+	Remove(val T)                                // helper function to build/update graph. removes T from graph along with every edge into or out of T. O(in + out)
+	Empty() bool                                 // returns whether graph is empty. O(1)
+	DepthFirstTraversal() []T                    // returns values in graph ordered by DFS processing along outgoing edges. O(n)
+	BreadthFirstTraversal() []T                  // returns values in graph ordered by BFS processing along outgoing edges. O(n)
+	Size() int                                   // returns number of items in graph.
+	AddEdge(from T, to T, weight float64)        // adds nodes if not present and an edge from -> to with weight; overwrites the weight of an existing edge. O(1)
+	RemoveEdge(from T, to T) bool                // removes the edge from -> to. returns whether the edge existed. O(1)
+	Weight(from T, to T) (float64, bool)         // returns the weight of the edge from -> to and whether the edge exists. O(1)
+	SetWeight(from T, to T, weight float64) bool // changes the weight of an existing edge from -> to. returns whether the edge exists. O(1)
+	NodeValue(key T) (any, bool)                 // returns the payload stored for key by Insert and whether key is in the graph. O(1)
+	Nodes() []T                                  // returns every node in the graph's NodeOrder. O(n), O(nlogn) when ordered
+	OutNeighbors(key T) []T                      // returns the nodes key has an edge to, in the graph's NodeOrder. O(out), O(out log out) when ordered
+	InNeighbors(key T) []T                       // returns the nodes that have an edge to key, in the graph's NodeOrder. O(in), O(in log in) when ordered
+	OutDegree(key T) int                         // returns the number of edges leaving key. O(1)
+	InDegree(key T) int                          // returns the number of edges entering key. O(1)
+	Reverse() *DirectedGraph[T]                  // returns a new graph with every edge flipped, keeping weights and node values. O(n + e)
+}
+
+// DirectedGraph is the directed counterpart of Graph: an edge from -> to does
+// not imply to -> from.
+type DirectedGraph[T constraints.Ordered] struct {
+	nodes    map[T]struct{}
+	out      map[T]map[T]struct{} // out[a] holds every b with an edge a -> b
+	in       map[T]map[T]struct{} // in[b] holds every a with an edge a -> b
+	weights  map[T]map[T]float64  // weights[a][b] is the weight of a -> b; 1 unless set by AddEdge or SetWeight
+	values   map[T]any            // payload from the Pair passed to Insert
+	order    NodeOrder
+	rank     map[T]int // when each node was added, for InsertionOrder
+	nextRank int
+}
+
+func NewDirectedGraph[T constraints.Ordered]() *DirectedGraph[T] {
+	return NewOrderedDirectedGraph[T](MapOrder)
+}
+
+// NewOrderedDirectedGraph returns an empty directed graph that enumerates
+// nodes and neighbors, and so traverses, in the given order.
+func NewOrderedDirectedGraph[T constraints.Ordered](order NodeOrder) *DirectedGraph[T] {
+	return &DirectedGraph[T]{
+		nodes:   make(map[T]struct{}),
+		out:     make(map[T]map[T]struct{}),
+		in:      make(map[T]map[T]struct{}),
+		weights: make(map[T]map[T]float64),
+		values:  make(map[T]any),
+		order:   order,
+		rank:    make(map[T]int),
+	}
+}
+
+func (g *DirectedGraph[T]) Empty() bool {
+	return len(g.nodes) == 0
+}
+
+func (g *DirectedGraph[T]) Size() int {
+	return len(g.nodes)
+}
+
+func (g *DirectedGraph[T]) Insert(pair Pair[T], neighbors []T) {
+	key := pair.Key
+
+	g.addNode(key)
+	g.values[key] = pair.Value
+
+	for _, neighbor := range neighbors {
+		g.addNode(neighbor)
+		if _, ok := g.out[key][neighbor]; ok {
+			continue // keep the weight of an existing edge
+		}
+		g.out[key][neighbor] = struct{}{}
+		g.in[neighbor][key] = struct{}{}
+		g.weights[key][neighbor] = 1
+	}
+}
+
+func (g *DirectedGraph[T]) Remove(key T) {
+	if _, ok := g.nodes[key]; !ok {
+		return
+	}
+	for neighbor := range g.out[key] {
+		delete(g.in[neighbor], key)
+	}
+	for neighbor := range g.in[key] {
+		delete(g.out[neighbor], key)
+		delete(g.weights[neighbor], key)
+	}
+	delete(g.nodes, key)
+	delete(g.out, key)
+	delete(g.in, key)
+	delete(g.weights, key)
+	delete(g.values, key)
+	delete(g.rank, key)
+}
+
+// addNode adds key with no edges if it is not already in the graph.
+func (g *DirectedGraph[T]) addNode(key T) {
+	if _, ok := g.nodes[key]; ok {
+		return
+	}
+	g.nodes[key] = struct{}{}
+	g.out[key] = make(map[T]struct{})
+	g.in[key] = make(map[T]struct{})
+	g.weights[key] = make(map[T]float64)
+	g.rank[key] = g.nextRank
+	g.nextRank++
+}
+
+func (g *DirectedGraph[T]) AddEdge(from T, to T, weight float64) {
+	g.addNode(from)
+	g.addNode(to)
+	g.out[from][to] = struct{}{}
+	g.in[to][from] = struct{}{}
+	g.weights[from][to] = weight
+}
+
+func (g *DirectedGraph[T]) RemoveEdge(from T, to T) bool {
+	if _, ok := g.out[from][to]; !ok {
+		return false
+	}
+	delete(g.out[from], to)
+	delete(g.in[to], from)
+	delete(g.weights[from], to)
+	return true
+}
+
+func (g *DirectedGraph[T]) Weight(from T, to T) (float64, bool) {
+	weight, ok := g.weights[from][to]
+	return weight, ok
+}
+
+func (g *DirectedGraph[T]) SetWeight(from T, to T, weight float64) bool {
+	if _, ok := g.out[from][to]; !ok {
+		return false
+	}
+	g.weights[from][to] = weight
+	return true
+}
+
+func (g *DirectedGraph[T]) NodeValue(key T) (any, bool) {
+	if _, ok := g.nodes[key]; !ok {
+		return nil, false
+	}
+	return g.values[key], true
+}
+
+func (g *DirectedGraph[T]) Nodes() []T {
+	return g.view().nodeList()
+}
+
+func (g *DirectedGraph[T]) OutNeighbors(key T) []T {
+	return g.view().neighborList(key)
+}
+
+func (g *DirectedGraph[T]) InNeighbors(key T) []T {
+	return g.view().ordered(g.in[key])
+}
+
+func (g *DirectedGraph[T]) OutDegree(key T) int {
+	return len(g.out[key])
+}
+
+func (g *DirectedGraph[T]) InDegree(key T) int {
+	return len(g.in[key])
+}
+
+func (g *DirectedGraph[T]) Reverse() *DirectedGraph[T] {
+	reversed := NewOrderedDirectedGraph[T](g.order)
+	for _, node := range g.Nodes() {
+		reversed.addNode(node)
+		reversed.values[node] = g.values[node]
+	}
+	for from, neighbors := range g.out {
+		for to := range neighbors {
+			reversed.AddEdge(to, from, g.weights[from][to])
+		}
+	}
+	return reversed
+}
+
+func (g *DirectedGraph[T]) DepthFirstTraversal() []T {
+	return g.view().depthFirstTraversal()
+}
+
+func (g *DirectedGraph[T]) BreadthFirstTraversal() []T {
+	return g.view().breadthFirstTraversal()
+}
+
+func (g *DirectedGraph[T]) view() graphView[T] {
+	return graphView[T]{nodes: g.nodes, adj: g.out, weights: g.weights, order: g.order, rank: g.rank}
+}
@@ -0,0 +1,205 @@
+package datastructures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ DirectedGraphInterface[string] = (*DirectedGraph[string])(nil)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Insert and Remove */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDirectedGraph_InsertRemove(t *testing.T) {
+
+	// Happy Path
+	t.Run("Insert only adds outgoing edges", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+
+		g.Insert(Pair[string]{Key: "build", Value: "go build"}, []string{"test", "lint"})
+
+		assert.Equal(t, 3, g.Size())
+		assert.ElementsMatch(t, []string{"test", "lint"}, g.OutNeighbors("build"))
+		assert.Empty(t, g.OutNeighbors("test"))
+		assert.Equal(t, []string{"build"}, g.InNeighbors("test"))
+		val, _ := g.NodeValue("build")
+		assert.Equal(t, "go build", val)
+	})
+
+	// Happy Path
+	t.Run("Remove drops incoming and outgoing edges", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", 2)
+		g.AddEdge("c", "b", 3)
+
+		g.Remove("b")
+
+		assert.Equal(t, 2, g.Size())
+		assert.Equal(t, 0, g.OutDegree("a"))
+		assert.Equal(t, 0, g.InDegree("c"))
+		assert.Equal(t, 0, g.OutDegree("c"))
+		_, ok := g.Weight("c", "b")
+		assert.False(t, ok)
+	})
+
+	// Edge Case
+	t.Run("Remove on a missing node does nothing", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 1)
+
+		g.Remove("z")
+
+		assert.Equal(t, 2, g.Size())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for edges and degrees */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDirectedGraph_Edges(t *testing.T) {
+
+	// Happy Path
+	t.Run("Edges are one way with independent weights", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 5)
+		g.AddEdge("b", "a", 7)
+
+		weight, _ := g.Weight("a", "b")
+		assert.Equal(t, 5.0, weight)
+		weight, _ = g.Weight("b", "a")
+		assert.Equal(t, 7.0, weight)
+
+		assert.True(t, g.SetWeight("a", "b", 9))
+		weight, _ = g.Weight("b", "a")
+		assert.Equal(t, 7.0, weight)
+	})
+
+	// Happy Path
+	t.Run("Degrees count edges in each direction", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+		g.Insert(Pair[int]{Key: 1}, []int{2, 3, 4})
+		g.Insert(Pair[int]{Key: 2}, []int{4})
+
+		assert.Equal(t, 3, g.OutDegree(1))
+		assert.Equal(t, 0, g.InDegree(1))
+		assert.Equal(t, 2, g.InDegree(4))
+		assert.ElementsMatch(t, []int{1, 2}, g.InNeighbors(4))
+	})
+
+	// Edge Case
+	t.Run("RemoveEdge only removes one direction", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "a", 1)
+
+		assert.True(t, g.RemoveEdge("a", "b"))
+		assert.False(t, g.RemoveEdge("a", "b"))
+
+		assert.Equal(t, 0, g.OutDegree("a"))
+		assert.Equal(t, 1, g.OutDegree("b"))
+		assert.False(t, g.SetWeight("a", "b", 3))
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Reverse */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDirectedGraph_Reverse(t *testing.T) {
+
+	// Happy Path
+	t.Run("Reverse flips every edge and keeps weights and values", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.Insert(Pair[string]{Key: "a", Value: 1}, nil)
+		g.AddEdge("a", "b", 4)
+		g.AddEdge("b", "c", 6)
+		g.Insert(Pair[string]{Key: "lonely"}, nil)
+
+		r := g.Reverse()
+
+		assert.Equal(t, 4, r.Size())
+		assert.Equal(t, []string{"a"}, r.OutNeighbors("b"))
+		assert.Equal(t, []string{"b"}, r.OutNeighbors("c"))
+		assert.Empty(t, r.OutNeighbors("a"))
+		weight, _ := r.Weight("c", "b")
+		assert.Equal(t, 6.0, weight)
+		val, _ := r.NodeValue("a")
+		assert.Equal(t, 1, val)
+	})
+
+	// Edge Case
+	t.Run("Reverse does not modify the original", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 1)
+
+		r := g.Reverse()
+		r.AddEdge("x", "y", 1)
+
+		assert.Equal(t, 2, g.Size())
+		assert.Equal(t, []string{"b"}, g.OutNeighbors("a"))
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for DepthFirstTraversal and BreadthFirstTraversal */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDirectedGraph_Traversals(t *testing.T) {
+
+	// Happy Path
+	t.Run("Traversals visit every node once", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+		g.Insert(Pair[int]{Key: 1}, []int{2, 3})
+		g.Insert(Pair[int]{Key: 3}, []int{4})
+		g.Insert(Pair[int]{Key: 5}, []int{1})
+
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, g.DepthFirstTraversal())
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, g.BreadthFirstTraversal())
+	})
+
+	// Happy Path
+	t.Run("A chain is walked along edge direction from its source", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+		g.AddEdge(1, 2, 1)
+
+		dfs := g.DepthFirstTraversal()
+
+		// Whichever root is picked first, 2 can never lead back to 1.
+		if dfs[0] == 2 {
+			assert.Equal(t, []int{2, 1}, dfs)
+		} else {
+			assert.Equal(t, []int{1, 2}, dfs)
+		}
+	})
+
+	// Happy Path
+	t.Run("Ordered graphs traverse deterministically", func(t *testing.T) {
+		build := func(order NodeOrder) *DirectedGraph[int] {
+			g := NewOrderedDirectedGraph[int](order)
+			g.Insert(Pair[int]{Key: 1}, []int{3, 2})
+			g.Insert(Pair[int]{Key: 5}, []int{1})
+			g.Insert(Pair[int]{Key: 4}, []int{3})
+			return g
+		}
+
+		byKey := build(KeyOrder)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, byKey.DepthFirstTraversal())
+		assert.Equal(t, []int{2, 3}, byKey.OutNeighbors(1))
+		assert.Equal(t, []int{1, 4}, byKey.InNeighbors(3))
+
+		byInsertion := build(InsertionOrder)
+		assert.Equal(t, []int{1, 3, 2, 5, 4}, byInsertion.DepthFirstTraversal())
+		assert.Equal(t, []int{1, 3, 2, 5, 4}, byInsertion.BreadthFirstTraversal())
+		assert.Equal(t, []int{3, 2}, byInsertion.OutNeighbors(1))
+		assert.Equal(t, []int{1, 3, 2, 5, 4}, byInsertion.Reverse().Nodes())
+	})
+
+	// Edge Case
+	t.Run("Traversals of an empty graph are empty", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+
+		assert.True(t, g.Empty())
+		assert.Empty(t, g.DepthFirstTraversal())
+		assert.Empty(t, g.BreadthFirstTraversal())
+	})
+}
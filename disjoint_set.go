@@ -0,0 +1,121 @@
+package datastructures
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// union-find
+type DisjointSetInterface[T constraints.Ordered] interface {
+	MakeSet(x T) bool        // adds x as a set of its own. returns false if x is already present. O(1)
+	Find(x T) (T, bool)      // returns the representative of x's set and whether x is present. amortized O(α(n))
+	Union(a T, b T) bool     // merges the sets of a and b, adding either if absent. returns whether they were separate. amortized O(α(n))
+	Connected(a T, b T) bool // returns whether a and b are present and in the same set. amortized O(α(n))
+	SetSize(x T) int         // returns the number of elements in x's set, or 0 if x is absent. amortized O(α(n))
+	Count() int              // returns the number of disjoint sets. O(1)
+	Size() int               // returns the number of elements across all sets. O(1)
+	Groups() [][]T           // returns the elements of every set, each sorted, ordered by smallest element. O(nlogn)
+}
+
+// DisjointSet keeps elements in disjoint sets, each named by one of its
+// elements, its representative. Path compression and union by rank keep every
+// operation amortized near-constant.
+type DisjointSet[T constraints.Ordered] struct {
+	parent map[T]T   // parent[x] == x for a representative
+	rank   map[T]int // upper bound on the height of a representative's tree
+	size   map[T]int // number of elements in a representative's set
+}
+
+func NewDisjointSet[T constraints.Ordered]() *DisjointSet[T] {
+	return &DisjointSet[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+		size:   make(map[T]int),
+	}
+}
+
+func (d *DisjointSet[T]) MakeSet(x T) bool {
+	if _, ok := d.parent[x]; ok {
+		return false
+	}
+	d.parent[x] = x
+	d.rank[x] = 0
+	d.size[x] = 1
+	return true
+}
+
+func (d *DisjointSet[T]) Find(x T) (T, bool) {
+	if _, ok := d.parent[x]; !ok {
+		var zero T
+		return zero, false
+	}
+	root := x
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+	// path compression: point everything on the way straight at the root
+	for x != root {
+		x, d.parent[x] = d.parent[x], root
+	}
+	return root, true
+}
+
+func (d *DisjointSet[T]) Union(a T, b T) bool {
+	d.MakeSet(a)
+	d.MakeSet(b)
+	rootA, _ := d.Find(a)
+	rootB, _ := d.Find(b)
+	if rootA == rootB {
+		return false
+	}
+	// union by rank: hang the shorter tree under the taller one
+	if d.rank[rootA] < d.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	d.parent[rootB] = rootA
+	if d.rank[rootA] == d.rank[rootB] {
+		d.rank[rootA]++
+	}
+	d.size[rootA] += d.size[rootB]
+	delete(d.rank, rootB)
+	delete(d.size, rootB)
+	return true
+}
+
+func (d *DisjointSet[T]) Connected(a T, b T) bool {
+	rootA, okA := d.Find(a)
+	rootB, okB := d.Find(b)
+	return okA && okB && rootA == rootB
+}
+
+func (d *DisjointSet[T]) SetSize(x T) int {
+	root, ok := d.Find(x)
+	if !ok {
+		return 0
+	}
+	return d.size[root]
+}
+
+func (d *DisjointSet[T]) Count() int {
+	return len(d.size)
+}
+
+func (d *DisjointSet[T]) Size() int {
+	return len(d.parent)
+}
+
+func (d *DisjointSet[T]) Groups() [][]T {
+	byRoot := make(map[T][]T, len(d.size))
+	for x := range d.parent {
+		root, _ := d.Find(x)
+		byRoot[root] = append(byRoot[root], x)
+	}
+	groups := make([][]T, 0, len(byRoot))
+	for _, group := range byRoot {
+		sort.Slice(group, func(i, j int) bool { return group[i] < group[j] })
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0] < groups[j][0] })
+	return groups
+}
@@ -0,0 +1,193 @@
+package datastructures
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for MakeSet, Find, Union and Connected */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDisjointSet_UnionFind(t *testing.T) {
+
+	// Happy Path
+	t.Run("New elements are their own sets", func(t *testing.T) {
+		d := NewDisjointSet[string]()
+
+		assert.True(t, d.MakeSet("a"))
+		assert.False(t, d.MakeSet("a"))
+
+		root, ok := d.Find("a")
+		assert.True(t, ok)
+		assert.Equal(t, "a", root)
+		assert.True(t, d.Connected("a", "a"))
+	})
+
+	// Happy Path
+	t.Run("Union joins sets transitively", func(t *testing.T) {
+		d := NewDisjointSet[int]()
+
+		assert.True(t, d.Union(1, 2))
+		assert.True(t, d.Union(3, 4))
+		assert.False(t, d.Connected(1, 4))
+		assert.True(t, d.Union(2, 3))
+		assert.False(t, d.Union(1, 4))
+
+		assert.True(t, d.Connected(1, 4))
+		rootOne, _ := d.Find(1)
+		rootFour, _ := d.Find(4)
+		assert.Equal(t, rootOne, rootFour)
+	})
+
+	// Happy Path
+	t.Run("Matches a naive labeling on random unions", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(9))
+		d := NewDisjointSet[int]()
+		label := make([]int, 200)
+		for i := range label {
+			d.MakeSet(i)
+			label[i] = i
+		}
+
+		for step := 0; step < 150; step++ {
+			a, b := rng.Intn(200), rng.Intn(200)
+			assert.Equal(t, label[a] != label[b], d.Union(a, b))
+			old := label[b]
+			for i := range label {
+				if label[i] == old {
+					label[i] = label[a]
+				}
+			}
+		}
+
+		for step := 0; step < 500; step++ {
+			a, b := rng.Intn(200), rng.Intn(200)
+			assert.Equal(t, label[a] == label[b], d.Connected(a, b))
+		}
+	})
+
+	// Edge Case
+	t.Run("A long chain of unions stays fast to search", func(t *testing.T) {
+		d := NewDisjointSet[int]()
+		for i := 1; i < 1000000; i++ {
+			d.Union(i-1, i)
+		}
+
+		assert.True(t, d.Connected(0, 999999))
+		assert.Equal(t, 1000000, d.SetSize(500000))
+	})
+
+	// Edge Case
+	t.Run("Absent elements", func(t *testing.T) {
+		d := NewDisjointSet[int]()
+		d.MakeSet(1)
+
+		_, ok := d.Find(2)
+		assert.False(t, ok)
+		assert.False(t, d.Connected(1, 2))
+		assert.False(t, d.Connected(2, 2))
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for path compression and union by rank */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDisjointSet_Balancing(t *testing.T) {
+
+	// Happy Path
+	t.Run("Find points every element on the path at the root", func(t *testing.T) {
+		d := NewDisjointSet[int]()
+		for i := 0; i < 4; i++ {
+			d.MakeSet(i)
+		}
+		// build the chain 0 -> 1 -> 2 -> 3 by hand so there is a path to compress
+		d.parent[0], d.parent[1], d.parent[2] = 1, 2, 3
+
+		root, ok := d.Find(0)
+
+		assert.True(t, ok)
+		assert.Equal(t, 3, root)
+		assert.Equal(t, map[int]int{0: 3, 1: 3, 2: 3, 3: 3}, d.parent)
+	})
+
+	// Happy Path
+	t.Run("The shorter tree hangs under the taller one", func(t *testing.T) {
+		d := NewDisjointSet[string]()
+		d.Union("a", "b")
+		tall, _ := d.Find("a")
+
+		d.Union("c", tall)
+
+		root, _ := d.Find("c")
+		assert.Equal(t, tall, root)
+		assert.Equal(t, 1, d.rank[tall])
+	})
+
+	// Edge Case
+	t.Run("Equal ranks grow the tree by one", func(t *testing.T) {
+		d := NewDisjointSet[int]()
+		d.Union(1, 2)
+		d.Union(3, 4)
+
+		d.Union(1, 3)
+
+		root, _ := d.Find(4)
+		assert.Equal(t, 2, d.rank[root])
+		for _, x := range []int{1, 2, 3, 4} {
+			r, _ := d.Find(x)
+			assert.Equal(t, root, r)
+		}
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for SetSize, Count, Size and Groups */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDisjointSet_Groups(t *testing.T) {
+
+	// Happy Path
+	t.Run("Clusters points within a distance", func(t *testing.T) {
+		points := []float64{1.0, 1.2, 5.0, 5.1, 5.3, 9.0}
+		d := NewDisjointSet[float64]()
+		for i, p := range points {
+			d.MakeSet(p)
+			for _, q := range points[:i] {
+				if p-q < 0.5 {
+					d.Union(p, q)
+				}
+			}
+		}
+
+		assert.Equal(t, 3, d.Count())
+		assert.Equal(t, 6, d.Size())
+		assert.Equal(t, 3, d.SetSize(5.1))
+		assert.Equal(t, [][]float64{{1.0, 1.2}, {5.0, 5.1, 5.3}, {9.0}}, d.Groups())
+	})
+
+	// Happy Path
+	t.Run("Count drops with every successful union", func(t *testing.T) {
+		d := NewDisjointSet[int]()
+		for i := 0; i < 5; i++ {
+			d.MakeSet(i)
+		}
+
+		d.Union(0, 1)
+		d.Union(1, 0)
+		d.Union(2, 3)
+
+		assert.Equal(t, 3, d.Count())
+		assert.Equal(t, 2, d.SetSize(3))
+		assert.Equal(t, 1, d.SetSize(4))
+	})
+
+	// Edge Case
+	t.Run("Empty and absent", func(t *testing.T) {
+		d := NewDisjointSet[string]()
+
+		assert.Equal(t, 0, d.Count())
+		assert.Equal(t, 0, d.SetSize("a"))
+		assert.Empty(t, d.Groups())
+	})
+}
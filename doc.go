@@ -0,0 +1,4 @@
+// Package datastructures provides generic implementations of common data
+// structures: linked lists, stacks, queues, deques, binary search trees,
+// graphs, sets, maps, heaps and priority queues.
+package datastructures
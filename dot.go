@@ -0,0 +1,407 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ReadDOT reads an undirected graph written in Graphviz DOT: a "graph" block
+// of node statements and "--" edge chains. An edge's weight comes from its
+// weight attribute and defaults to 1; other attributes, attribute statements
+// and comments are skipped. Subgraphs are not supported.
+func ReadDOT[T constraints.Ordered](r io.Reader, parse KeyParser[T]) (*Graph[T], error) {
+	g := NewOrderedGraph[T](InsertionOrder)
+	if err := readDOT[T](r, parse, g, false); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// ReadDirectedDOT reads a "digraph" block in the subset of DOT that ReadDOT
+// accepts, with "->" edges.
+func ReadDirectedDOT[T constraints.Ordered](r io.Reader, parse KeyParser[T]) (*DirectedGraph[T], error) {
+	g := NewOrderedDirectedGraph[T](InsertionOrder)
+	if err := readDOT[T](r, parse, g, true); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// WriteDOT writes the graph as a DOT "graph" block that ReadDOT reads back.
+func (g *Graph[T]) WriteDOT(w io.Writer) error {
+	return g.view().writeDOT(w, false)
+}
+
+// WriteDOT writes the graph as a DOT "digraph" block that ReadDirectedDOT reads back.
+func (g *DirectedGraph[T]) WriteDOT(w io.Writer) error {
+	return g.view().writeDOT(w, true)
+}
+
+func (v graphView[T]) writeDOT(w io.Writer, directed bool) error {
+	var b strings.Builder
+	kind, op := "graph", "--"
+	if directed {
+		kind, op = "digraph", "->"
+	}
+	b.WriteString(kind + " {\n")
+	keyed := v
+	keyed.order = KeyOrder
+	for _, node := range keyed.nodeList() {
+		b.WriteString("\t" + dotQuote(fmt.Sprint(node)) + ";\n")
+	}
+	for _, edge := range v.edgeList(directed) {
+		b.WriteString("\t" + dotQuote(fmt.Sprint(edge.From)) + " " + op + " " + dotQuote(fmt.Sprint(edge.To)))
+		if edge.Weight != 1 {
+			// DOT numerals have no exponent, and anything else must be quoted
+			weight := strconv.FormatFloat(edge.Weight, 'f', -1, 64)
+			if math.IsInf(edge.Weight, 0) || math.IsNaN(edge.Weight) {
+				weight = dotQuote(weight)
+			}
+			b.WriteString(" [weight=" + weight + "]")
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotQuote(text string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(text, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+type dotToken struct {
+	text   string
+	quoted bool // a quoted ID, never a keyword or punctuation
+	line   int
+}
+
+// dotLexer splits DOT source into IDs and punctuation, dropping whitespace and comments.
+type dotLexer struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (l *dotLexer) errorf(format string, args ...any) error {
+	return &ParseError{Format: "dot", Line: l.line, Msg: fmt.Sprintf(format, args...)}
+}
+
+// next returns the next token, or one with empty text at the end of the input.
+func (l *dotLexer) next() (dotToken, error) {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\n':
+			l.line++
+			l.pos++
+		case c == ' ' || c == '\t' || c == '\r':
+			l.pos++
+		case c == '#' || strings.HasPrefix(l.src[l.pos:], "//"):
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		case strings.HasPrefix(l.src[l.pos:], "/*"):
+			end := strings.Index(l.src[l.pos+2:], "*/")
+			if end < 0 {
+				return dotToken{}, l.errorf("unterminated comment")
+			}
+			comment := l.src[l.pos : l.pos+2+end+2]
+			l.line += strings.Count(comment, "\n")
+			l.pos += len(comment)
+		default:
+			return l.token()
+		}
+	}
+	return dotToken{line: l.line}, nil
+}
+
+func (l *dotLexer) token() (dotToken, error) {
+	start, rest := l.pos, l.src[l.pos:]
+	tok := dotToken{line: l.line}
+	switch c := rest[0]; {
+	case strings.HasPrefix(rest, "--") || strings.HasPrefix(rest, "->"):
+		l.pos += 2
+	case strings.ContainsRune("{}[];=,", rune(c)):
+		l.pos++
+	case c == '"':
+		var text strings.Builder
+		for l.pos++; ; l.pos++ {
+			if l.pos >= len(l.src) {
+				return dotToken{}, &ParseError{Format: "dot", Line: tok.line, Msg: "unterminated string"}
+			}
+			c := l.src[l.pos]
+			if c == '"' {
+				l.pos++
+				break
+			}
+			if c == '\\' && l.pos+1 < len(l.src) && (l.src[l.pos+1] == '"' || l.src[l.pos+1] == '\\') {
+				l.pos++
+				c = l.src[l.pos]
+			}
+			if c == '\n' {
+				l.line++
+			}
+			text.WriteByte(c)
+		}
+		tok.text, tok.quoted = text.String(), true
+		return tok, nil
+	case isDOTLetter(c):
+		for l.pos < len(l.src) && (isDOTLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+	case isDigit(c) || c == '.' || c == '-':
+		l.pos++
+		for l.pos < len(l.src) && (isDigit(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		if l.pos-start == 1 && !isDigit(c) {
+			return dotToken{}, l.errorf("unexpected %q", c)
+		}
+	default:
+		return dotToken{}, l.errorf("unexpected %q", c)
+	}
+	tok.text = l.src[start:l.pos]
+	return tok, nil
+}
+
+func isDOTLetter(c byte) bool {
+	return c == '_' || c >= 0x80 || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// dotParser reads one graph block from a dotLexer, one token of lookahead at a time.
+type dotParser[T constraints.Ordered] struct {
+	lex      *dotLexer
+	tok      dotToken
+	parse    KeyParser[T]
+	g        graphBuilder[T]
+	directed bool
+}
+
+func readDOT[T constraints.Ordered](r io.Reader, parse KeyParser[T], g graphBuilder[T], directed bool) error {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	p := &dotParser[T]{lex: &dotLexer{src: string(src), line: 1}, parse: parse, g: g, directed: directed}
+	if err := p.advance(); err != nil {
+		return err
+	}
+	return p.graph()
+}
+
+func (p *dotParser[T]) advance() error {
+	tok, err := p.lex.next()
+	p.tok = tok
+	return err
+}
+
+func (p *dotParser[T]) errorf(format string, args ...any) error {
+	return &ParseError{Format: "dot", Line: p.tok.line, Msg: fmt.Sprintf(format, args...)}
+}
+
+// is reports whether the current token is the unquoted text, ignoring case as DOT keywords do.
+func (p *dotParser[T]) is(text string) bool {
+	return !p.tok.quoted && strings.EqualFold(p.tok.text, text)
+}
+
+func (p *dotParser[T]) expect(text string) error {
+	if !p.is(text) {
+		return p.errorf("want %q, got %s", text, p.describe())
+	}
+	return p.advance()
+}
+
+func (p *dotParser[T]) describe() string {
+	if p.tok.text == "" && !p.tok.quoted {
+		return "end of input"
+	}
+	return strconv.Quote(p.tok.text)
+}
+
+// isID reports whether the current token can name a node or attribute value.
+func (p *dotParser[T]) isID() bool {
+	if p.tok.quoted {
+		return true
+	}
+	switch p.tok.text {
+	case "", "{", "}", "[", "]", ";", "=", ",", "--", "->":
+		return false
+	}
+	return true
+}
+
+// graph: ["strict"] ("graph" | "digraph") [ID] "{" statement* "}"
+func (p *dotParser[T]) graph() error {
+	if p.is("strict") {
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+	want, other := "graph", "digraph"
+	if p.directed {
+		want, other = other, want
+	}
+	if p.is(other) {
+		return p.errorf("want %q, got %q", want, other)
+	}
+	if err := p.expect(want); err != nil {
+		return err
+	}
+	if p.isID() {
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.is("}") {
+		if err := p.statement(); err != nil {
+			return err
+		}
+	}
+	if err := p.advance(); err != nil {
+		return err
+	}
+	if p.tok.text != "" || p.tok.quoted {
+		return p.errorf("unexpected %s after the graph", p.describe())
+	}
+	return nil
+}
+
+// statement: ("graph" | "node" | "edge") attributes | ID "=" ID | ID (edgeop ID)* [attributes], then an optional ";"
+func (p *dotParser[T]) statement() error {
+	switch {
+	case p.is("graph") || p.is("node") || p.is("edge"):
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if _, err := p.attributes(); err != nil {
+			return err
+		}
+	case p.is("subgraph") || p.is("{"):
+		return p.errorf("subgraphs are not supported")
+	case p.isID():
+		if err := p.chain(); err != nil {
+			return err
+		}
+	default:
+		return p.errorf("want a statement, got %s", p.describe())
+	}
+	if p.is(";") {
+		return p.advance()
+	}
+	return nil
+}
+
+// chain reads a node statement, a graph attribute, or a chain of edges.
+func (p *dotParser[T]) chain() error {
+	line, first := p.tok.line, p.tok.text
+	if err := p.advance(); err != nil {
+		return err
+	}
+	if p.is("=") {
+		// a graph attribute such as rankdir=LR
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if !p.isID() {
+			return p.errorf("want a value for %q, got %s", first, p.describe())
+		}
+		return p.advance()
+	}
+
+	texts, lines := []string{first}, []int{line}
+	kind, op, wrong := "graph", "--", "->"
+	if p.directed {
+		kind, op, wrong = "digraph", wrong, op
+	}
+	for p.is(op) || p.is(wrong) {
+		if p.is(wrong) {
+			return p.errorf("%q edge in a %s", wrong, kind)
+		}
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if !p.isID() {
+			return p.errorf("want a node after %q, got %s", op, p.describe())
+		}
+		texts, lines = append(texts, p.tok.text), append(lines, p.tok.line)
+		if err := p.advance(); err != nil {
+			return err
+		}
+	}
+	attrs, err := p.attributes()
+	if err != nil {
+		return err
+	}
+
+	keys := make([]T, len(texts))
+	for i, text := range texts {
+		if keys[i], err = parseKey(p.parse, text, "dot", lines[i]); err != nil {
+			return err
+		}
+	}
+	if len(keys) == 1 {
+		p.g.Insert(Pair[T]{Key: keys[0]}, nil)
+		return nil
+	}
+	weight := 1.0
+	if text, ok := attrs["weight"]; ok {
+		if weight, err = strconv.ParseFloat(text, 64); err != nil {
+			return &ParseError{Format: "dot", Line: line, Msg: fmt.Sprintf("bad weight %q", text)}
+		}
+	}
+	for i := 1; i < len(keys); i++ {
+		p.g.AddEdge(keys[i-1], keys[i], weight)
+	}
+	return nil
+}
+
+// attributes: ("[" (ID "=" ID [";" | ","])* "]")*
+func (p *dotParser[T]) attributes() (map[string]string, error) {
+	attrs := map[string]string{}
+	for p.is("[") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		for !p.is("]") {
+			if !p.isID() {
+				return nil, p.errorf("want an attribute, got %s", p.describe())
+			}
+			name := p.tok.text
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			if !p.isID() {
+				return nil, p.errorf("want a value for %q, got %s", name, p.describe())
+			}
+			attrs[name] = p.tok.text
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if p.is(",") || p.is(";") {
+				if err := p.advance(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	return attrs, nil
+}
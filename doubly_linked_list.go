@@ -1,4 +1,4 @@
-package main
+package datastructures
 
 type DoubleLinkNode[T any] struct {
 	Data T
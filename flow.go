@@ -0,0 +1,274 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrSourceIsSink = errors.New("graph: source and sink are the same node")
+
+// FlowAlgorithm selects how MaxFlow finds augmenting paths.
+type FlowAlgorithm int
+
+const (
+	EdmondsKarp FlowAlgorithm = iota // O(n * e^2); augments along one shortest path at a time
+	Dinic                            // O(n^2 * e); augments along every shortest path of a level graph per phase
+)
+
+// flowEpsilon is the residual capacity below which an edge counts as saturated,
+// so rounding left over from float subtraction cannot keep a search going.
+const flowEpsilon = 1e-9
+
+// FlowNetwork is a DirectedGraph whose edge weights are capacities.
+type FlowNetwork[T constraints.Ordered] struct {
+	*DirectedGraph[T]
+}
+
+func NewFlowNetwork[T constraints.Ordered]() *FlowNetwork[T] {
+	return &FlowNetwork[T]{DirectedGraph: NewDirectedGraph[T]()}
+}
+
+// FlowNetworkOf treats the weights of g as capacities. The network shares g,
+// so later changes to g show up in the network.
+func FlowNetworkOf[T constraints.Ordered](g *DirectedGraph[T]) *FlowNetwork[T] {
+	return &FlowNetwork[T]{DirectedGraph: g}
+}
+
+// Flow is a maximum flow together with the minimum cut that proves it.
+type Flow[T constraints.Ordered] struct {
+	Value      float64   // total flow leaving the source
+	SourceSide []T       // nodes still reachable from the source in the residual network
+	SinkSide   []T       // every other node
+	Cut        []Edge[T] // edges from SourceSide to SinkSide at their capacity; their weights sum to Value
+	flow       map[T]map[T]float64
+}
+
+// EdgeFlow returns the flow sent along from -> to, which is 0 for an edge
+// that carries none or does not exist.
+func (f *Flow[T]) EdgeFlow(from T, to T) float64 {
+	return f.flow[from][to]
+}
+
+// Flows returns every edge that carries flow, with the flow as its weight,
+// sorted by From and then To.
+func (f *Flow[T]) Flows() []Edge[T] {
+	edges := []Edge[T]{}
+	for from, row := range f.flow {
+		for to, amount := range row {
+			edges = append(edges, Edge[T]{From: from, To: to, Weight: amount})
+		}
+	}
+	sortEdges(edges)
+	return edges
+}
+
+// MaxFlow sends as much flow as the capacities allow from source to sink and
+// returns it with a minimum cut. Capacities must not be negative.
+func (n *FlowNetwork[T]) MaxFlow(source T, sink T, algorithm FlowAlgorithm) (*Flow[T], error) {
+	for _, node := range []T{source, sink} {
+		if _, ok := n.nodes[node]; !ok {
+			return nil, fmt.Errorf("%w: %v", ErrNodeNotFound, node)
+		}
+	}
+	if source == sink {
+		return nil, fmt.Errorf("%w: %v", ErrSourceIsSink, source)
+	}
+	r, err := newResidual(n.view(), n.in)
+	if err != nil {
+		return nil, err
+	}
+
+	var value float64
+	if algorithm == Dinic {
+		value = r.dinic(source, sink)
+	} else {
+		value = r.edmondsKarp(source, sink)
+	}
+	return r.result(source, value), nil
+}
+
+// residual tracks how much more flow each edge can take. Pushing flow along
+// u -> v lowers capacity[u][v] and raises capacity[v][u], so later paths can
+// cancel it.
+type residual[T constraints.Ordered] struct {
+	v        graphView[T]
+	capacity map[T]map[T]float64
+	adj      map[T][]T // both directions of every edge, in the view's NodeOrder
+}
+
+func newResidual[T constraints.Ordered](v graphView[T], in map[T]map[T]struct{}) (*residual[T], error) {
+	r := &residual[T]{
+		v:        v,
+		capacity: make(map[T]map[T]float64, len(v.nodes)),
+		adj:      make(map[T][]T, len(v.nodes)),
+	}
+	for node := range v.nodes {
+		r.capacity[node] = make(map[T]float64)
+	}
+	for from, neighbors := range v.adj {
+		for to := range neighbors {
+			weight := v.weights[from][to]
+			if weight < 0 {
+				return nil, fmt.Errorf("%w: %v to %v is %v", ErrNegativeWeight, from, to, weight)
+			}
+			r.capacity[from][to] += weight
+		}
+	}
+	for node := range v.nodes {
+		both := make(map[T]struct{}, len(v.adj[node])+len(in[node]))
+		for neighbor := range v.adj[node] {
+			both[neighbor] = struct{}{}
+		}
+		for neighbor := range in[node] {
+			both[neighbor] = struct{}{}
+		}
+		r.adj[node] = v.ordered(both)
+	}
+	return r, nil
+}
+
+func (r *residual[T]) push(from T, to T, amount float64) {
+	r.capacity[from][to] -= amount
+	r.capacity[to][from] += amount
+}
+
+// levels returns each node's BFS distance from source over edges with
+// capacity left.
+func (r *residual[T]) levels(source T) map[T]int {
+	level := map[T]int{source: 0}
+	queue := NewQueue[T]()
+	queue.Enqueue(source)
+	for !queue.Empty() {
+		node := queue.Front()
+		queue.Dequeue()
+		for _, neighbor := range r.adj[node] {
+			if _, ok := level[neighbor]; ok || r.capacity[node][neighbor] <= flowEpsilon {
+				continue
+			}
+			level[neighbor] = level[node] + 1
+			queue.Enqueue(neighbor)
+		}
+	}
+	return level
+}
+
+func (r *residual[T]) edmondsKarp(source T, sink T) float64 {
+	total := 0.0
+	for {
+		// BFS for the shortest path with capacity left
+		prev := map[T]T{}
+		seen := map[T]struct{}{source: {}}
+		queue := NewQueue[T]()
+		queue.Enqueue(source)
+		for !queue.Empty() {
+			node := queue.Front()
+			queue.Dequeue()
+			if node == sink {
+				break
+			}
+			for _, neighbor := range r.adj[node] {
+				if _, ok := seen[neighbor]; ok || r.capacity[node][neighbor] <= flowEpsilon {
+					continue
+				}
+				seen[neighbor] = struct{}{}
+				prev[neighbor] = node
+				queue.Enqueue(neighbor)
+			}
+		}
+		if _, ok := seen[sink]; !ok {
+			return total
+		}
+
+		bottleneck := r.capacity[prev[sink]][sink]
+		for node := sink; node != source; node = prev[node] {
+			bottleneck = min(bottleneck, r.capacity[prev[node]][node])
+		}
+		for node := sink; node != source; node = prev[node] {
+			r.push(prev[node], node, bottleneck)
+		}
+		total += bottleneck
+	}
+}
+
+func (r *residual[T]) dinic(source T, sink T) float64 {
+	total := 0.0
+	for {
+		level := r.levels(source)
+		if _, ok := level[sink]; !ok {
+			return total
+		}
+		// next[node] indexes the first edge of node that may still lead to sink
+		// in this phase; edges before it are saturated or dead ends.
+		next := make(map[T]int, len(level))
+		for {
+			path := []T{source}
+			for len(path) > 0 && path[len(path)-1] != sink {
+				node := path[len(path)-1]
+				advanced := false
+				for ; next[node] < len(r.adj[node]); next[node]++ {
+					neighbor := r.adj[node][next[node]]
+					if depth, ok := level[neighbor]; ok && depth == level[node]+1 && r.capacity[node][neighbor] > flowEpsilon {
+						path = append(path, neighbor)
+						advanced = true
+						break
+					}
+				}
+				if !advanced {
+					// node cannot reach sink this phase; retreat and skip the edge into it
+					path = path[:len(path)-1]
+					if len(path) > 0 {
+						next[path[len(path)-1]]++
+					}
+				}
+			}
+			if len(path) == 0 {
+				break
+			}
+
+			bottleneck := r.capacity[path[0]][path[1]]
+			for i := 1; i < len(path); i++ {
+				bottleneck = min(bottleneck, r.capacity[path[i-1]][path[i]])
+			}
+			for i := 1; i < len(path); i++ {
+				r.push(path[i-1], path[i], bottleneck)
+			}
+			total += bottleneck
+		}
+	}
+}
+
+// result reads the flow on each edge back out of the residual capacities and
+// takes the minimum cut at the nodes the source can still reach.
+func (r *residual[T]) result(source T, value float64) *Flow[T] {
+	f := &Flow[T]{Value: value, flow: make(map[T]map[T]float64)}
+	for from, neighbors := range r.v.adj {
+		for to := range neighbors {
+			// capacity[from][to] started at the edge's capacity; whatever is
+			// missing is net flow from -> to, and a negative amount belongs to to -> from.
+			if sent := r.v.weights[from][to] - r.capacity[from][to]; sent > flowEpsilon {
+				if f.flow[from] == nil {
+					f.flow[from] = make(map[T]float64)
+				}
+				f.flow[from][to] = sent
+			}
+		}
+	}
+
+	reachable := r.levels(source)
+	for _, node := range r.v.nodeList() {
+		if _, ok := reachable[node]; ok {
+			f.SourceSide = append(f.SourceSide, node)
+			for to := range r.v.adj[node] {
+				if _, ok := reachable[to]; !ok {
+					f.Cut = append(f.Cut, Edge[T]{From: node, To: to, Weight: r.v.weights[node][to]})
+				}
+			}
+		} else {
+			f.SinkSide = append(f.SinkSide, node)
+		}
+	}
+	sortEdges(f.Cut)
+	return f
+}
@@ -0,0 +1,145 @@
+package datastructures
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clrsFlowNetwork is the maximum flow example from CLRS; its maximum flow is 23.
+func clrsFlowNetwork() *FlowNetwork[string] {
+	n := NewFlowNetwork[string]()
+	for _, edge := range []Edge[string]{
+		{"s", "v1", 16}, {"s", "v2", 13}, {"v1", "v3", 12}, {"v2", "v1", 4}, {"v2", "v4", 14},
+		{"v3", "v2", 9}, {"v3", "t", 20}, {"v4", "v3", 7}, {"v4", "t", 4},
+	} {
+		n.AddEdge(edge.From, edge.To, edge.Weight)
+	}
+	return n
+}
+
+// assertValidFlow checks capacities, conservation at every inner node, and
+// that the cut weighs as much as the flow.
+func assertValidFlow[T int | string](t *testing.T, n *FlowNetwork[T], f *Flow[T], source T, sink T) {
+	t.Helper()
+	balance := map[T]float64{}
+	for _, edge := range f.Flows() {
+		capacity, ok := n.Weight(edge.From, edge.To)
+		assert.True(t, ok)
+		assert.LessOrEqual(t, edge.Weight, capacity+flowEpsilon)
+		balance[edge.From] -= edge.Weight
+		balance[edge.To] += edge.Weight
+	}
+	for node, amount := range balance {
+		if node != source && node != sink {
+			assert.InDelta(t, 0, amount, 1e-6, "flow not conserved at %v", node)
+		}
+	}
+	assert.InDelta(t, f.Value, balance[sink], 1e-6)
+
+	cut := 0.0
+	for _, edge := range f.Cut {
+		cut += edge.Weight
+		assert.InDelta(t, edge.Weight, f.EdgeFlow(edge.From, edge.To), 1e-6, "cut edge not saturated")
+	}
+	assert.InDelta(t, f.Value, cut, 1e-6)
+	assert.Len(t, append(f.SourceSide, f.SinkSide...), n.Size())
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for MaxFlow */
+/*--------------------------------------------------------------------------------------------------*/
+func TestFlowNetwork_MaxFlow(t *testing.T) {
+
+	// Happy Path
+	t.Run("Textbook network with both algorithms", func(t *testing.T) {
+		for _, algorithm := range []FlowAlgorithm{EdmondsKarp, Dinic} {
+			n := clrsFlowNetwork()
+
+			f, err := n.MaxFlow("s", "t", algorithm)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 23.0, f.Value)
+			assert.ElementsMatch(t, []string{"s", "v1", "v2", "v4"}, f.SourceSide)
+			assert.ElementsMatch(t, []string{"v3", "t"}, f.SinkSide)
+			assert.Equal(t, []Edge[string]{
+				{From: "v1", To: "v3", Weight: 12},
+				{From: "v4", To: "t", Weight: 4},
+				{From: "v4", To: "v3", Weight: 7},
+			}, f.Cut)
+			assertValidFlow(t, n, f, "s", "t")
+		}
+	})
+
+	// Happy Path
+	t.Run("Flow can be cancelled along a reverse edge", func(t *testing.T) {
+		// The shortest path s-a-b-t uses a -> b, which the maximum flow of 2 must undo.
+		for _, algorithm := range []FlowAlgorithm{EdmondsKarp, Dinic} {
+			n := NewFlowNetwork[string]()
+			n.AddEdge("s", "a", 1)
+			n.AddEdge("s", "b", 1)
+			n.AddEdge("a", "b", 1)
+			n.AddEdge("a", "t", 1)
+			n.AddEdge("b", "t", 1)
+			n.AddEdge("b", "a", 1)
+
+			f, _ := n.MaxFlow("s", "t", algorithm)
+
+			assert.Equal(t, 2.0, f.Value)
+			assertValidFlow(t, n, f, "s", "t")
+		}
+	})
+
+	// Happy Path
+	t.Run("Both algorithms agree on random networks", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(21))
+		for trial := 0; trial < 30; trial++ {
+			g := NewDirectedGraph[int]()
+			for i := 0; i < 12; i++ {
+				g.Insert(Pair[int]{Key: i}, nil)
+			}
+			for i := 0; i < 40; i++ {
+				g.AddEdge(rng.Intn(12), rng.Intn(12), float64(rng.Intn(10)))
+			}
+			n := FlowNetworkOf(g)
+
+			edmondsKarp, err := n.MaxFlow(0, 11, EdmondsKarp)
+			assert.NoError(t, err)
+			dinic, err := n.MaxFlow(0, 11, Dinic)
+			assert.NoError(t, err)
+
+			assert.InDelta(t, edmondsKarp.Value, dinic.Value, 1e-9)
+			assertValidFlow(t, n, edmondsKarp, 0, 11)
+			assertValidFlow(t, n, dinic, 0, 11)
+		}
+	})
+
+	// Edge Case
+	t.Run("An unreachable sink gets no flow", func(t *testing.T) {
+		n := NewFlowNetwork[int]()
+		n.AddEdge(1, 2, 5)
+		n.AddEdge(3, 4, 5)
+
+		f, err := n.MaxFlow(1, 4, Dinic)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, f.Value)
+		assert.Empty(t, f.Flows())
+		assert.Empty(t, f.Cut)
+	})
+
+	// Edge Case
+	t.Run("Invalid networks and endpoints", func(t *testing.T) {
+		n := clrsFlowNetwork()
+
+		_, err := n.MaxFlow("s", "nowhere", EdmondsKarp)
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+		_, err = n.MaxFlow("s", "s", EdmondsKarp)
+		assert.ErrorIs(t, err, ErrSourceIsSink)
+
+		n.SetWeight("v1", "v3", -1)
+		_, err = n.MaxFlow("s", "t", Dinic)
+		assert.ErrorIs(t, err, ErrNegativeWeight)
+	})
+}
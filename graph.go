@@ -1,16 +1,52 @@
-package main
+package datastructures
 
 import (
+	"sort"
+
 	"golang.org/x/exp/constraints"
 )
 
 type GraphInterface[T constraints.Ordered] interface {
-	Insert(val Pair[T], neighbors []T) // helper function to build/update graph. inserts T into graph if not present, adds neighbors. O(neighbors)
-	Remove(val T)                      // helper function to build/update graph. removes T from graph, removes T from other nodes' neighbors.  O(neighbors)
-	Empty() bool                       // returns whether graph is empty. O(1)
-	DepthFirstTraversal() []T          // returns values in graph ordered by DFS processing. O(n)
-	BreadthFirstTraversal() []T        // returns values in graph ordered by BFS processing. O(n)
-	Size() int                         // returns number of items in graph.
+	Insert(val Pair[T], neighbors []T)           // helper function to build/update graph. inserts T into graph if not present, stores val.Value, adds neighbors with weight 1. O(neighbors)
+	Remove(val T)                                // helper function to build/update graph. removes T from graph, removes T from other nodes' neighbors.  O(neighbors)
+	Empty() bool                                 // returns whether graph is empty. O(1)
+	DepthFirstTraversal() []T                    // returns values in graph ordered by DFS processing. O(n)
+	BreadthFirstTraversal() []T                  // returns values in graph ordered by BFS processing. O(n)
+	Size() int                                   // returns number of items in graph.
+	AddEdge(from T, to T, weight float64)        // adds nodes if not present and an edge between them with weight; overwrites the weight of an existing edge. O(1)
+	RemoveEdge(from T, to T) bool                // removes the edge between from and to. returns whether the edge existed. O(1)
+	Weight(from T, to T) (float64, bool)         // returns the weight of the edge between from and to and whether the edge exists. O(1)
+	SetWeight(from T, to T, weight float64) bool // changes the weight of an existing edge. returns whether the edge exists. O(1)
+	NodeValue(key T) (any, bool)                 // returns the payload stored for key by Insert and whether key is in the graph. O(1)
+	Nodes() []T                                  // returns every node in the graph's NodeOrder. O(n), O(nlogn) when ordered
+	Neighbors(key T) []T                         // returns key's neighbors in the graph's NodeOrder. O(neighbors), O(neighbors log neighbors) when ordered
+}
+
+// NodeOrder controls the order in which a graph enumerates nodes and
+// neighbors, and therefore the order of its traversals.
+type NodeOrder int
+
+const (
+	MapOrder       NodeOrder = iota // Go map iteration order: fastest, but different on every run
+	KeyOrder                        // ascending by key
+	InsertionOrder                  // the order nodes were first added to the graph
+)
+
+// Edge is a weighted edge; in a DirectedGraph it runs From -> To.
+type Edge[T constraints.Ordered] struct {
+	From   T
+	To     T
+	Weight float64
+}
+
+// sortEdges sorts edges by From and then To.
+func sortEdges[T constraints.Ordered](edges []Edge[T]) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
 }
 
 type Graph[T constraints.Ordered] struct {
@@ -18,12 +54,28 @@ type Graph[T constraints.Ordered] struct {
 	// Using second map as a set; ignore the value
 	// Ensure on removals you use delete func
 	neighbors map[T]map[T]struct{}
+	weights   map[T]map[T]float64 // weights[a][b] == weights[b][a] for every edge; 1 unless set by AddEdge or SetWeight
+	values    map[T]any           // payload from the Pair passed to Insert
+	order     NodeOrder
+	rank      map[T]int // when each node was added, for InsertionOrder
+	nextRank  int
 }
 
 func NewGraph[T constraints.Ordered]() *Graph[T] {
+	return NewOrderedGraph[T](MapOrder)
+}
+
+// NewOrderedGraph returns an empty graph whose node and neighbor enumeration,
+// and so every traversal, follows order. KeyOrder and InsertionOrder make
+// traversals reproducible from run to run.
+func NewOrderedGraph[T constraints.Ordered](order NodeOrder) *Graph[T] {
 	return &Graph[T]{
 		nodes:     make(map[T]struct{}),
 		neighbors: make(map[T]map[T]struct{}),
+		weights:   make(map[T]map[T]float64),
+		values:    make(map[T]any),
+		order:     order,
+		rank:      make(map[T]int),
 	}
 }
 
@@ -38,19 +90,18 @@ func (g *Graph[T]) Size() int {
 func (g *Graph[T]) Insert(pair Pair[T], neighbors []T) {
 	key := pair.Key
 
-	g.nodes[key] = struct{}{}
-
-	if g.neighbors[key] == nil {
-		g.neighbors[key] = make(map[T]struct{})
-	}
+	g.addNode(key)
+	g.values[key] = pair.Value
 
 	for _, neighbor := range neighbors {
-		g.nodes[neighbor] = struct{}{}
-		if g.neighbors[neighbor] == nil {
-			g.neighbors[neighbor] = make(map[T]struct{})
+		g.addNode(neighbor)
+		if _, ok := g.neighbors[key][neighbor]; ok {
+			continue // keep the weight of an existing edge
 		}
 		g.neighbors[key][neighbor] = struct{}{} // empty struct type have no fields and takes up zero bytes of memory
 		g.neighbors[neighbor][key] = struct{}{} // great to use to track existence of key without any associated data
+		g.weights[key][neighbor] = 1
+		g.weights[neighbor][key] = 1
 	}
 
 	// set := make(map[T]struct{})
@@ -68,90 +119,150 @@ func (g *Graph[T]) Remove(key T) {
 	neighbors := g.neighbors[key]
 	for neighbor, _ := range neighbors {
 		delete(g.neighbors[neighbor], key)
+		delete(g.weights[neighbor], key)
 	}
 	delete(g.neighbors, key)
+	delete(g.weights, key)
+	delete(g.values, key)
+	delete(g.rank, key)
 }
 
-// did not give starting node.
-func (g *Graph[T]) DepthFirstTraversal() []T {
-	visited := make(map[T]struct{})
-	result := []T{}
+// addNode adds key with no neighbors if it is not already in the graph.
+func (g *Graph[T]) addNode(key T) {
+	if _, ok := g.nodes[key]; ok {
+		return
+	}
+	g.nodes[key] = struct{}{}
+	g.neighbors[key] = make(map[T]struct{})
+	g.weights[key] = make(map[T]float64)
+	g.rank[key] = g.nextRank
+	g.nextRank++
+}
 
-	var helperDFS func(node T)
-	helperDFS = func(node T) {
-		if _, ok := visited[node]; ok {
-			return
-		}
+func (g *Graph[T]) AddEdge(from T, to T, weight float64) {
+	g.addNode(from)
+	g.addNode(to)
+	g.neighbors[from][to] = struct{}{}
+	g.neighbors[to][from] = struct{}{}
+	g.weights[from][to] = weight
+	g.weights[to][from] = weight
+}
 
-		visited[node] = struct{}{}
-		result = append(result, node)
+func (g *Graph[T]) RemoveEdge(from T, to T) bool {
+	if _, ok := g.neighbors[from][to]; !ok {
+		return false
+	}
+	delete(g.neighbors[from], to)
+	delete(g.neighbors[to], from)
+	delete(g.weights[from], to)
+	delete(g.weights[to], from)
+	return true
+}
 
-		for neighbor := range g.neighbors[node] {
-			helperDFS(neighbor)
-		}
+func (g *Graph[T]) Weight(from T, to T) (float64, bool) {
+	weight, ok := g.weights[from][to]
+	return weight, ok
+}
+
+func (g *Graph[T]) SetWeight(from T, to T, weight float64) bool {
+	if _, ok := g.neighbors[from][to]; !ok {
+		return false
 	}
+	g.weights[from][to] = weight
+	g.weights[to][from] = weight
+	return true
+}
 
-	for node := range g.nodes {
-		if _, ok := visited[node]; !ok {
-			helperDFS(node)
-		}
+func (g *Graph[T]) NodeValue(key T) (any, bool) {
+	if _, ok := g.nodes[key]; !ok {
+		return nil, false
 	}
+	return g.values[key], true
+}
 
-	return result
+func (g *Graph[T]) Nodes() []T {
+	return g.view().nodeList()
 }
 
-//sorted version
-// func (g *Graph[T]) DepthFirstTraversal() []T {
-// 	visited := make(map[T]struct{})
-// 	result := []T{}
+func (g *Graph[T]) Neighbors(key T) []T {
+	return g.view().neighborList(key)
+}
 
-// 	var helperDFS func(node T)
-// 	helperDFS = func(node T) {
-// 		if _, ok := visited[node]; ok {
-// 			return
-// 		}
+// graphView is the adjacency that Graph and DirectedGraph share with the
+// traversal and path algorithms. adj holds each node's outgoing neighbors,
+// which for an undirected Graph are all of its neighbors.
+type graphView[T constraints.Ordered] struct {
+	nodes   map[T]struct{}
+	adj     map[T]map[T]struct{}
+	weights map[T]map[T]float64
+	order   NodeOrder
+	rank    map[T]int
+}
 
-// 		visited[node] = struct{}{}
-// 		result = append(result, node)
+func (g *Graph[T]) view() graphView[T] {
+	return graphView[T]{nodes: g.nodes, adj: g.neighbors, weights: g.weights, order: g.order, rank: g.rank}
+}
 
-// 		neighbors := []T{}
-// 		for neighbor := range g.neighbors[node] {
-// 			neighbors = append(neighbors, neighbor)
-// 		}
+// nodeList returns every node in the view's NodeOrder.
+func (v graphView[T]) nodeList() []T {
+	return v.ordered(v.nodes)
+}
 
-// 		sort.Slice(neighbors, func(i, j int) bool {
-// 			return neighbors[i] < neighbors[j]
-// 		})
+// neighborList returns the outgoing neighbors of node in the view's NodeOrder.
+func (v graphView[T]) neighborList(node T) []T {
+	return v.ordered(v.adj[node])
+}
 
-// 		for _, neighbor := range neighbors {
-// 			helperDFS(neighbor)
-// 		}
-// 	}
+// orderedAdjacency returns nodeList and every node's neighborList, for
+// algorithms that sweep all edges many times.
+func (v graphView[T]) orderedAdjacency() ([]T, map[T][]T) {
+	nodes := v.nodeList()
+	adj := make(map[T][]T, len(nodes))
+	for _, node := range nodes {
+		adj[node] = v.neighborList(node)
+	}
+	return nodes, adj
+}
+
+func (v graphView[T]) ordered(set map[T]struct{}) []T {
+	keys := make([]T, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	return v.sortNodes(keys)
+}
 
-// 	nodes := []T{}
-// 	for node := range g.nodes {
-// 		nodes = append(nodes, node)
-// 	}
+// sortNodes sorts keys in place into the view's NodeOrder and returns them.
+func (v graphView[T]) sortNodes(keys []T) []T {
+	switch v.order {
+	case KeyOrder:
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	case InsertionOrder:
+		sort.Slice(keys, func(i, j int) bool { return v.rank[keys[i]] < v.rank[keys[j]] })
+	}
+	return keys
+}
 
-// 	sort.Slice(nodes, func(i, j int) bool {
-// 		return nodes[i] < nodes[j]
-// 	})
+// did not give starting node.
+func (g *Graph[T]) DepthFirstTraversal() []T {
+	return g.view().depthFirstTraversal()
+}
 
-// 	for node := range g.nodes {
-// 		if _, ok := visited[node]; !ok {
-// 			helperDFS(node)
-// 		}
-// 	}
-// 	return result
-// }
+func (v graphView[T]) depthFirstTraversal() []T {
+	return v.depthFirstOrders().PreOrder
+}
 
 // dont use recursion
 func (g *Graph[T]) BreadthFirstTraversal() []T {
+	return g.view().breadthFirstTraversal()
+}
+
+func (v graphView[T]) breadthFirstTraversal() []T {
 	visited := make(map[T]struct{})
 	result := []T{}
 	queue := NewQueue[T]()
 
-	for node := range g.nodes {
+	for _, node := range v.nodeList() {
 		if _, ok := visited[node]; ok {
 			continue
 		}
@@ -169,7 +280,7 @@ func (g *Graph[T]) BreadthFirstTraversal() []T {
 			visited[front] = struct{}{}
 			result = append(result, front)
 
-			for neighbor := range g.neighbors[front] {
+			for _, neighbor := range v.neighborList(front) {
 				if _, ok := visited[neighbor]; !ok {
 					queue.Enqueue(neighbor)
 				}
@@ -0,0 +1,202 @@
+package datastructures
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// The readers and writers below cover three formats: Graphviz DOT, plain edge
+// lists and JSON adjacency. Writers list nodes and edges in ascending key
+// order and write a weight only where it is not the default of 1, and readers
+// return graphs in InsertionOrder so they traverse in file order. Node values
+// set by Insert are not written.
+
+var ErrSyntax = errors.New("graph: malformed input")
+
+// ParseError reports where a reader gave up on its input.
+type ParseError struct {
+	Format string // "dot", "edge list" or "json"
+	Line   int    // 1-based line of the input where the problem was found
+	Msg    string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v: %s line %d: %s", ErrSyntax, e.Format, e.Line, e.Msg)
+}
+
+func (e *ParseError) Unwrap() error {
+	return ErrSyntax
+}
+
+// KeyParser turns the text of a node key back into a key: strconv.Atoi for
+// int keys, for example, or StringKey for string keys.
+type KeyParser[T constraints.Ordered] func(text string) (T, error)
+
+// StringKey is the KeyParser for string keys.
+func StringKey(text string) (string, error) {
+	return text, nil
+}
+
+// graphBuilder is what the readers need from Graph and DirectedGraph.
+type graphBuilder[T constraints.Ordered] interface {
+	Insert(pair Pair[T], neighbors []T)
+	AddEdge(from T, to T, weight float64)
+	Weight(from T, to T) (float64, bool)
+}
+
+func parseKey[T constraints.Ordered](parse KeyParser[T], text string, format string, line int) (T, error) {
+	key, err := parse(text)
+	if err != nil {
+		return key, &ParseError{Format: format, Line: line, Msg: fmt.Sprintf("bad node key %q: %v", text, err)}
+	}
+	return key, nil
+}
+
+// edgeList returns the graph's edges in ascending order, each undirected edge once.
+func (v graphView[T]) edgeList(directed bool) []Edge[T] {
+	edges := []Edge[T]{}
+	keyed := v
+	keyed.order = KeyOrder
+	for _, from := range keyed.nodeList() {
+		for _, to := range keyed.neighborList(from) {
+			if directed || from <= to {
+				edges = append(edges, Edge[T]{From: from, To: to, Weight: v.weights[from][to]})
+			}
+		}
+	}
+	return edges
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Edge lists */
+/*--------------------------------------------------------------------------------------------------*/
+
+// ReadEdgeList reads an undirected graph with one edge per line as
+// "from to [weight]". A line holding a single key adds a node with no edges,
+// the weight defaults to 1, and everything after a # is a comment. Lines may
+// be up to 1 MiB long.
+func ReadEdgeList[T constraints.Ordered](r io.Reader, parse KeyParser[T]) (*Graph[T], error) {
+	g := NewOrderedGraph[T](InsertionOrder)
+	if err := readEdgeList[T](r, parse, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// ReadDirectedEdgeList reads a directed graph in the format of ReadEdgeList,
+// with each line's edge running from -> to.
+func ReadDirectedEdgeList[T constraints.Ordered](r io.Reader, parse KeyParser[T]) (*DirectedGraph[T], error) {
+	g := NewOrderedDirectedGraph[T](InsertionOrder)
+	if err := readEdgeList[T](r, parse, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// WriteEdgeList writes the graph in the format read by ReadEdgeList, each edge
+// once. Keys must print without whitespace or #.
+func (g *Graph[T]) WriteEdgeList(w io.Writer) error {
+	return g.view().writeEdgeList(w, false)
+}
+
+// WriteEdgeList writes the graph in the format read by ReadDirectedEdgeList.
+// Keys must print without whitespace or #.
+func (g *DirectedGraph[T]) WriteEdgeList(w io.Writer) error {
+	return g.view().writeEdgeList(w, true)
+}
+
+// maxEdgeListLine is the longest line readEdgeList accepts, in bytes.
+const maxEdgeListLine = 1 << 20
+
+func readEdgeList[T constraints.Ordered](r io.Reader, parse KeyParser[T], g graphBuilder[T]) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxEdgeListLine)
+	line := 1
+	for ; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) > 3 {
+			return &ParseError{Format: "edge list", Line: line, Msg: fmt.Sprintf("want \"from to [weight]\", got %d fields", len(fields))}
+		}
+
+		from, err := parseKey(parse, fields[0], "edge list", line)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 1 {
+			g.Insert(Pair[T]{Key: from}, nil)
+			continue
+		}
+		to, err := parseKey(parse, fields[1], "edge list", line)
+		if err != nil {
+			return err
+		}
+		weight := 1.0
+		if len(fields) == 3 {
+			if weight, err = strconv.ParseFloat(fields[2], 64); err != nil {
+				return &ParseError{Format: "edge list", Line: line, Msg: fmt.Sprintf("bad weight %q", fields[2])}
+			}
+		}
+		g.AddEdge(from, to, weight)
+	}
+	// line is now the line the scanner failed on
+	if err := scanner.Err(); errors.Is(err, bufio.ErrTooLong) {
+		return &ParseError{Format: "edge list", Line: line, Msg: fmt.Sprintf("line longer than %d bytes", maxEdgeListLine)}
+	} else if err != nil {
+		return fmt.Errorf("graph: edge list line %d: %w", line, err)
+	}
+	return nil
+}
+
+func (v graphView[T]) writeEdgeList(w io.Writer, directed bool) error {
+	var b strings.Builder
+	keyText := func(key T) (string, error) {
+		text := fmt.Sprint(key)
+		if text == "" || strings.ContainsAny(text, "# \t\n\v\f\r") {
+			return "", fmt.Errorf("graph: key %q cannot be written to an edge list", text)
+		}
+		return text, nil
+	}
+
+	hasEdge := make(map[T]struct{}, len(v.nodes))
+	for _, edge := range v.edgeList(directed) {
+		from, err := keyText(edge.From)
+		if err != nil {
+			return err
+		}
+		to, err := keyText(edge.To)
+		if err != nil {
+			return err
+		}
+		hasEdge[edge.From], hasEdge[edge.To] = struct{}{}, struct{}{}
+		b.WriteString(from + " " + to)
+		if edge.Weight != 1 {
+			b.WriteString(" " + strconv.FormatFloat(edge.Weight, 'g', -1, 64))
+		}
+		b.WriteByte('\n')
+	}
+	// nodes without edges would otherwise be lost
+	keyed := v
+	keyed.order = KeyOrder
+	for _, node := range keyed.nodeList() {
+		if _, ok := hasEdge[node]; ok {
+			continue
+		}
+		text, err := keyText(node)
+		if err != nil {
+			return err
+		}
+		b.WriteString(text + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
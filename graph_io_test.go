@@ -0,0 +1,316 @@
+package datastructures
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func smallGraph() *Graph[string] {
+	g := NewGraph[string]()
+	g.AddEdge("a", "b", 2.5)
+	g.AddEdge("c", "b", 1)
+	g.Insert(Pair[string]{Key: "d"}, nil)
+	return g
+}
+
+func smallDirectedGraph() *DirectedGraph[int] {
+	g := NewDirectedGraph[int]()
+	g.AddEdge(10, 2, -0.125)
+	g.AddEdge(2, 10, 3)
+	g.AddEdge(2, 2, 1)
+	g.AddEdge(7, 2, 1e-7)
+	g.Insert(Pair[int]{Key: 99}, nil)
+	return g
+}
+
+func assertParseError(t *testing.T, err error, format string, line int) {
+	t.Helper()
+	var parseErr *ParseError
+	if assert.True(t, errors.As(err, &parseErr), "got %v", err) {
+		assert.ErrorIs(t, err, ErrSyntax)
+		assert.Equal(t, format, parseErr.Format)
+		assert.Equal(t, line, parseErr.Line, parseErr.Error())
+	}
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for writers */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_Write(t *testing.T) {
+
+	// Happy Path
+	t.Run("Edge list", func(t *testing.T) {
+		var b strings.Builder
+
+		assert.NoError(t, smallGraph().WriteEdgeList(&b))
+		assert.Equal(t, "a b 2.5\nb c\nd\n", b.String())
+	})
+
+	// Happy Path
+	t.Run("DOT", func(t *testing.T) {
+		var b strings.Builder
+
+		assert.NoError(t, smallGraph().WriteDOT(&b))
+		assert.Equal(t, `graph {
+	"a";
+	"b";
+	"c";
+	"d";
+	"a" -- "b" [weight=2.5];
+	"b" -- "c";
+}
+`, b.String())
+	})
+
+	// Happy Path
+	t.Run("JSON", func(t *testing.T) {
+		var b strings.Builder
+
+		assert.NoError(t, smallGraph().WriteJSON(&b))
+		assert.Equal(t, `{
+  "directed": false,
+  "nodes": {
+    "a": {"b": 2.5},
+    "b": {"a": 2.5, "c": 1},
+    "c": {"b": 1},
+    "d": {}
+  }
+}
+`, b.String())
+	})
+
+	// Edge Case
+	t.Run("Keys an edge list cannot hold", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.AddEdge("New York", "Boston", 1)
+
+		assert.Error(t, g.WriteEdgeList(&strings.Builder{}))
+		assert.NoError(t, g.WriteDOT(&strings.Builder{}))
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for round trips */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_RoundTrip(t *testing.T) {
+	type format struct {
+		name      string
+		write     func(g *Graph[string], b *strings.Builder) error
+		read      func(r *strings.Reader) (*Graph[string], error)
+		writeDi   func(g *DirectedGraph[int], b *strings.Builder) error
+		readDi    func(r *strings.Reader) (*DirectedGraph[int], error)
+		hasSpaces bool
+	}
+	formats := []format{
+		{
+			name:    "edge list",
+			write:   func(g *Graph[string], b *strings.Builder) error { return g.WriteEdgeList(b) },
+			read:    func(r *strings.Reader) (*Graph[string], error) { return ReadEdgeList(r, StringKey) },
+			writeDi: func(g *DirectedGraph[int], b *strings.Builder) error { return g.WriteEdgeList(b) },
+			readDi:  func(r *strings.Reader) (*DirectedGraph[int], error) { return ReadDirectedEdgeList(r, strconv.Atoi) },
+		},
+		{
+			name:      "dot",
+			write:     func(g *Graph[string], b *strings.Builder) error { return g.WriteDOT(b) },
+			read:      func(r *strings.Reader) (*Graph[string], error) { return ReadDOT(r, StringKey) },
+			writeDi:   func(g *DirectedGraph[int], b *strings.Builder) error { return g.WriteDOT(b) },
+			readDi:    func(r *strings.Reader) (*DirectedGraph[int], error) { return ReadDirectedDOT(r, strconv.Atoi) },
+			hasSpaces: true,
+		},
+		{
+			name:      "json",
+			write:     func(g *Graph[string], b *strings.Builder) error { return g.WriteJSON(b) },
+			read:      func(r *strings.Reader) (*Graph[string], error) { return ReadJSON(r, StringKey) },
+			writeDi:   func(g *DirectedGraph[int], b *strings.Builder) error { return g.WriteJSON(b) },
+			readDi:    func(r *strings.Reader) (*DirectedGraph[int], error) { return ReadDirectedJSON(r, strconv.Atoi) },
+			hasSpaces: true,
+		},
+	}
+
+	for _, f := range formats {
+		// Happy Path
+		t.Run(f.name+" keeps an undirected graph", func(t *testing.T) {
+			graphs := []*Graph[string]{cityGraph(), smallGraph(), NewGraph[string]()}
+			if f.hasSpaces {
+				odd := NewGraph[string]()
+				odd.AddEdge(`say "hi"`, `back\slash`, 0.1)
+				odd.AddEdge("graph", "-->", 1)
+				graphs = append(graphs, odd)
+			}
+			for _, g := range graphs {
+				var b strings.Builder
+				assert.NoError(t, f.write(g, &b))
+
+				got, err := f.read(strings.NewReader(b.String()))
+
+				if !assert.NoError(t, err, b.String()) {
+					continue
+				}
+				assert.ElementsMatch(t, g.Nodes(), got.Nodes())
+				assert.Equal(t, g.view().edgeList(false), got.view().edgeList(false))
+			}
+		})
+
+		// Happy Path
+		t.Run(f.name+" keeps a directed graph", func(t *testing.T) {
+			g := smallDirectedGraph()
+			var b strings.Builder
+			assert.NoError(t, f.writeDi(g, &b))
+
+			got, err := f.readDi(strings.NewReader(b.String()))
+
+			if !assert.NoError(t, err, b.String()) {
+				return
+			}
+			assert.ElementsMatch(t, g.Nodes(), got.Nodes())
+			assert.Equal(t, g.view().edgeList(true), got.view().edgeList(true))
+		})
+	}
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for readers */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_Read(t *testing.T) {
+
+	// Happy Path
+	t.Run("Edge list with comments and optional weights", func(t *testing.T) {
+		g, err := ReadEdgeList(strings.NewReader(`
+# friends
+Alice   Bob
+Alice	Candy 2   # trailing comment
+Zed
+`), StringKey)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Alice", "Bob", "Candy", "Zed"}, g.Nodes())
+		weight, _ := g.Weight("Candy", "Alice")
+		assert.Equal(t, 2.0, weight)
+		weight, _ = g.Weight("Alice", "Bob")
+		assert.Equal(t, 1.0, weight)
+	})
+
+	// Happy Path
+	t.Run("DOT with chains, attributes and comments", func(t *testing.T) {
+		g, err := ReadDirectedDOT(strings.NewReader(`/* build
+   pipeline */
+strict DiGraph jobs {
+	rankdir=LR
+	node [shape=box];
+	checkout -> build -> "unit test" [weight=3, color=red]
+	// the deploy step
+	"unit test" -> deploy; lint
+	-1.5 -> build
+}`), StringKey)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"checkout", "build", "unit test", "deploy", "lint", "-1.5"}, g.Nodes())
+		weight, _ := g.Weight("build", "unit test")
+		assert.Equal(t, 3.0, weight)
+		weight, _ = g.Weight("unit test", "deploy")
+		assert.Equal(t, 1.0, weight)
+		assert.Equal(t, []string{"checkout", "-1.5"}, g.InNeighbors("build"))
+	})
+
+	// Happy Path
+	t.Run("JSON with an edge listed from one end", func(t *testing.T) {
+		g, err := ReadJSON(strings.NewReader(`{"nodes": {"1": {"2": 4}, "3": {}}}`), strconv.Atoi)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, g.Nodes())
+		weight, _ := g.Weight(2, 1)
+		assert.Equal(t, 4.0, weight)
+	})
+
+	// Edge Case
+	t.Run("Malformed edge lists name the line", func(t *testing.T) {
+		_, err := ReadEdgeList(strings.NewReader("a b\n\nb c heavy\n"), StringKey)
+		assertParseError(t, err, "edge list", 3)
+
+		_, err = ReadEdgeList(strings.NewReader("a b 1 2\n"), StringKey)
+		assertParseError(t, err, "edge list", 1)
+
+		_, err = ReadDirectedEdgeList(strings.NewReader("1 2\n2 x\n"), strconv.Atoi)
+		assertParseError(t, err, "edge list", 2)
+	})
+
+	// Edge Case
+	t.Run("Long edge list lines", func(t *testing.T) {
+		long := strings.Repeat("n", 100000)
+		g, err := ReadEdgeList(strings.NewReader("a b\n"+long+" a 2\n"), StringKey)
+		if assert.NoError(t, err) {
+			weight, ok := g.Weight(long, "a")
+			assert.True(t, ok)
+			assert.Equal(t, 2.0, weight)
+		}
+
+		tooLong := strings.Repeat("n", maxEdgeListLine+1)
+		_, err = ReadEdgeList(strings.NewReader("a b\n\n"+tooLong+"\n"), StringKey)
+		assertParseError(t, err, "edge list", 3)
+	})
+
+	// Edge Case
+	t.Run("Read errors from the edge list source name the line", func(t *testing.T) {
+		failure := errors.New("disk on fire")
+		r := io.MultiReader(strings.NewReader("a b\nb c\n"), iotest.ErrReader(failure))
+
+		_, err := ReadEdgeList(r, StringKey)
+
+		assert.ErrorIs(t, err, failure)
+		assert.ErrorContains(t, err, "line 3")
+	})
+
+	// Edge Case
+	t.Run("Malformed DOT names the line", func(t *testing.T) {
+		cases := []struct {
+			src  string
+			line int
+		}{
+			{"graph {\n a -- b\n c -> d\n}", 3},
+			{"digraph {\n a -> b\n}", 1},
+			{"graph {\n a -- \n}", 3},
+			{"graph {\n \"a\n\n -- b\n}", 2},
+			{"graph {\n a -- b [weight=heavy]\n}", 2},
+			{"graph {\n subgraph x { a }\n}", 2},
+			{"graph {\n a -- b\n", 3},
+			{"graph {\n a ! b\n}", 2},
+			{"graph { a }\ngraph { b }", 2},
+		}
+		for _, c := range cases {
+			_, err := ReadDOT(strings.NewReader(c.src), StringKey)
+			assertParseError(t, err, "dot", c.line)
+		}
+
+		_, err := ReadDirectedDOT(strings.NewReader("digraph {\n 1 -> 2\n\n 2 -> x\n}"), strconv.Atoi)
+		assertParseError(t, err, "dot", 4)
+	})
+
+	// Edge Case
+	t.Run("Malformed JSON names the line", func(t *testing.T) {
+		cases := []struct {
+			src  string
+			line int
+		}{
+			{"{\n \"nodes\": {\n  \"a\": {\"b\": 1,}\n }\n}", 3},
+			{"{\n \"directed\": true,\n \"nodes\": {}\n}", 2},
+			{"{\n \"nodes\": {\n  \"a\": {\"b\": \"1\"}\n }\n}", 3},
+			{"{\n \"nodes\": {\n  \"a\": {\"b\": 1},\n  \"b\": {\"a\": 2}\n }\n}", 4},
+			{"{\n \"edges\": []\n}", 2},
+			{"{\n \"directed\": false\n}", 3},
+			{"{\n \"nodes\": {\n", 3},
+		}
+		for _, c := range cases {
+			_, err := ReadJSON(strings.NewReader(c.src), StringKey)
+			assertParseError(t, err, "json", c.line)
+		}
+
+		_, err := ReadDirectedJSON(strings.NewReader("{\"directed\": true,\n\"nodes\": {\"1\": {\"x\": 1}}}"), strconv.Atoi)
+		assertParseError(t, err, "json", 2)
+	})
+}
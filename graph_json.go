@@ -0,0 +1,232 @@
+package datastructures
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ReadJSON reads an undirected graph from a JSON object of the form
+//
+//	{"directed": false, "nodes": {"a": {"b": 2.5}, "b": {"a": 2.5}, "c": {}}}
+//
+// where each node maps its neighbors to edge weights. An edge may be listed
+// from either end or both, but both ends must agree on its weight.
+func ReadJSON[T constraints.Ordered](r io.Reader, parse KeyParser[T]) (*Graph[T], error) {
+	g := NewOrderedGraph[T](InsertionOrder)
+	if err := readJSON[T](r, parse, g, false); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// ReadDirectedJSON reads a directed graph in the format of ReadJSON, with
+// "directed": true and each node mapping the nodes it has an edge to.
+func ReadDirectedJSON[T constraints.Ordered](r io.Reader, parse KeyParser[T]) (*DirectedGraph[T], error) {
+	g := NewOrderedDirectedGraph[T](InsertionOrder)
+	if err := readJSON[T](r, parse, g, true); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// WriteJSON writes the graph in the format read by ReadJSON, listing every
+// edge from both ends. Weights must be finite.
+func (g *Graph[T]) WriteJSON(w io.Writer) error {
+	return g.view().writeJSON(w, false)
+}
+
+// WriteJSON writes the graph in the format read by ReadDirectedJSON. Weights
+// must be finite.
+func (g *DirectedGraph[T]) WriteJSON(w io.Writer) error {
+	return g.view().writeJSON(w, true)
+}
+
+func (v graphView[T]) writeJSON(w io.Writer, directed bool) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "{\n  \"directed\": %t,\n  \"nodes\": {", directed)
+	keyed := v
+	keyed.order = KeyOrder
+	for i, node := range keyed.nodeList() {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		key, _ := json.Marshal(fmt.Sprint(node))
+		b.WriteString("\n    " + string(key) + ": {")
+		for j, neighbor := range keyed.neighborList(node) {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			key, _ := json.Marshal(fmt.Sprint(neighbor))
+			weight, err := json.Marshal(v.weights[node][neighbor])
+			if err != nil {
+				return fmt.Errorf("graph: weight of %v to %v: %w", node, neighbor, err)
+			}
+			b.WriteString(string(key) + ": " + string(weight))
+		}
+		b.WriteString("}")
+	}
+	if len(v.nodes) > 0 {
+		b.WriteString("\n  ")
+	}
+	b.WriteString("}\n}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// jsonReader walks the JSON token stream, keeping the input so every error can
+// name the line it was found on.
+type jsonReader[T constraints.Ordered] struct {
+	src    []byte
+	dec    *json.Decoder
+	parse  KeyParser[T]
+	offset int64 // input offset where the last token ended
+}
+
+func readJSON[T constraints.Ordered](r io.Reader, parse KeyParser[T], g graphBuilder[T], directed bool) error {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	jr := &jsonReader[T]{src: src, dec: json.NewDecoder(bytes.NewReader(src)), parse: parse}
+
+	if err := jr.delim('{'); err != nil {
+		return err
+	}
+	sawNodes := false
+	for jr.dec.More() {
+		field, err := jr.key()
+		if err != nil {
+			return err
+		}
+		switch field {
+		case "directed":
+			tok, err := jr.token()
+			if err != nil {
+				return err
+			}
+			isDirected, ok := tok.(bool)
+			if !ok {
+				return jr.errorf("\"directed\" must be true or false")
+			}
+			if isDirected != directed {
+				return jr.errorf("want \"directed\": %t", directed)
+			}
+		case "nodes":
+			if err := jr.nodes(g, directed); err != nil {
+				return err
+			}
+			sawNodes = true
+		default:
+			return jr.errorf("unknown field %q", field)
+		}
+	}
+	if err := jr.delim('}'); err != nil {
+		return err
+	}
+	if !sawNodes {
+		return jr.errorf("missing \"nodes\"")
+	}
+	if _, err := jr.dec.Token(); err != io.EOF {
+		return jr.errorf("unexpected data after the graph")
+	}
+	return nil
+}
+
+// nodes reads {"key": {"neighbor": weight, ...}, ...}.
+func (jr *jsonReader[T]) nodes(g graphBuilder[T], directed bool) error {
+	if err := jr.delim('{'); err != nil {
+		return err
+	}
+	for jr.dec.More() {
+		text, err := jr.key()
+		if err != nil {
+			return err
+		}
+		from, err := parseKey(jr.parse, text, "json", jr.line())
+		if err != nil {
+			return err
+		}
+		g.Insert(Pair[T]{Key: from}, nil)
+
+		if err := jr.delim('{'); err != nil {
+			return err
+		}
+		for jr.dec.More() {
+			text, err := jr.key()
+			if err != nil {
+				return err
+			}
+			to, err := parseKey(jr.parse, text, "json", jr.line())
+			if err != nil {
+				return err
+			}
+			tok, err := jr.token()
+			if err != nil {
+				return err
+			}
+			w, ok := tok.(float64)
+			if !ok {
+				return jr.errorf("weight of %q to %q must be a number", fmt.Sprint(from), text)
+			}
+			if existing, ok := g.Weight(from, to); ok && !directed && existing != w {
+				return jr.errorf("edge %v to %v has weights %v and %v", from, to, existing, w)
+			}
+			g.AddEdge(from, to, w)
+		}
+		if err := jr.delim('}'); err != nil {
+			return err
+		}
+	}
+	return jr.delim('}')
+}
+
+func (jr *jsonReader[T]) token() (json.Token, error) {
+	tok, err := jr.dec.Token()
+	if err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			jr.offset = syntaxErr.Offset
+			return nil, jr.errorf("%v", syntaxErr)
+		}
+		jr.offset = int64(len(jr.src))
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, jr.errorf("unexpected end of input")
+		}
+		return nil, jr.errorf("%v", err)
+	}
+	jr.offset = jr.dec.InputOffset()
+	return tok, nil
+}
+
+func (jr *jsonReader[T]) delim(want json.Delim) error {
+	tok, err := jr.token()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return jr.errorf("want %q, got %v", want, tok)
+	}
+	return nil
+}
+
+func (jr *jsonReader[T]) key() (string, error) {
+	tok, err := jr.token()
+	if err != nil {
+		return "", err
+	}
+	return tok.(string), nil // the decoder only allows strings as object keys
+}
+
+func (jr *jsonReader[T]) line() int {
+	return 1 + bytes.Count(jr.src[:min(jr.offset, int64(len(jr.src)))], []byte("\n"))
+}
+
+func (jr *jsonReader[T]) errorf(format string, args ...any) error {
+	return &ParseError{Format: "json", Line: jr.line(), Msg: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,144 @@
+package datastructures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ GraphInterface[string] = (*Graph[string])(nil)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Insert and NodeValue */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_InsertNodeValue(t *testing.T) {
+
+	// Happy Path
+	t.Run("Insert keeps the pair's value", func(t *testing.T) {
+		g := NewGraph[string]()
+
+		g.Insert(Pair[string]{Key: "NYC", Value: 8_336_817}, []string{"Boston"})
+
+		val, ok := g.NodeValue("NYC")
+		assert.True(t, ok)
+		assert.Equal(t, 8_336_817, val)
+	})
+
+	// Happy Path
+	t.Run("Inserting a node again replaces its value", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.Insert(Pair[string]{Key: "NYC", Value: "old"}, nil)
+
+		g.Insert(Pair[string]{Key: "NYC", Value: "new"}, nil)
+
+		val, _ := g.NodeValue("NYC")
+		assert.Equal(t, "new", val)
+	})
+
+	// Edge Case
+	t.Run("Nodes added only as neighbors have no value", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.Insert(Pair[string]{Key: "NYC", Value: 1}, []string{"Boston"})
+
+		val, ok := g.NodeValue("Boston")
+
+		assert.True(t, ok)
+		assert.Nil(t, val)
+	})
+
+	// Edge Case
+	t.Run("Missing and removed nodes report not found", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.Insert(Pair[string]{Key: "NYC", Value: 1}, nil)
+		g.Remove("NYC")
+
+		_, ok := g.NodeValue("NYC")
+		assert.False(t, ok)
+		_, ok = g.NodeValue("Bermuda")
+		assert.False(t, ok)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for AddEdge, Weight, SetWeight and RemoveEdge */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_Weights(t *testing.T) {
+
+	// Happy Path
+	t.Run("AddEdge stores the weight in both directions", func(t *testing.T) {
+		g := NewGraph[string]()
+
+		g.AddEdge("NYC", "Boston", 215.5)
+
+		assert.Equal(t, 2, g.Size())
+		weight, ok := g.Weight("NYC", "Boston")
+		assert.True(t, ok)
+		assert.Equal(t, 215.5, weight)
+		weight, ok = g.Weight("Boston", "NYC")
+		assert.True(t, ok)
+		assert.Equal(t, 215.5, weight)
+	})
+
+	// Happy Path
+	t.Run("Insert edges default to weight 1 and keep existing weights", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.AddEdge("NYC", "DC", 225)
+
+		g.Insert(Pair[string]{Key: "NYC"}, []string{"Boston", "DC"})
+
+		weight, _ := g.Weight("NYC", "Boston")
+		assert.Equal(t, 1.0, weight)
+		weight, _ = g.Weight("DC", "NYC")
+		assert.Equal(t, 225.0, weight)
+	})
+
+	// Happy Path
+	t.Run("SetWeight changes an existing edge", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.AddEdge("NYC", "Boston", 215)
+
+		assert.True(t, g.SetWeight("Boston", "NYC", 190))
+
+		weight, _ := g.Weight("NYC", "Boston")
+		assert.Equal(t, 190.0, weight)
+	})
+
+	// Edge Case
+	t.Run("SetWeight on a missing edge does nothing", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.Insert(Pair[string]{Key: "NYC"}, nil)
+		g.Insert(Pair[string]{Key: "Bermuda"}, nil)
+
+		assert.False(t, g.SetWeight("NYC", "Bermuda", 1))
+
+		_, ok := g.Weight("NYC", "Bermuda")
+		assert.False(t, ok)
+	})
+
+	// Happy Path
+	t.Run("RemoveEdge keeps both nodes", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.AddEdge("NYC", "Boston", 215)
+
+		assert.True(t, g.RemoveEdge("Boston", "NYC"))
+		assert.False(t, g.RemoveEdge("Boston", "NYC"))
+
+		_, ok := g.Weight("NYC", "Boston")
+		assert.False(t, ok)
+		assert.Equal(t, 2, g.Size())
+	})
+
+	// Edge Case
+	t.Run("Remove drops the node's edges and weights", func(t *testing.T) {
+		g := NewGraph[string]()
+		g.AddEdge("NYC", "Boston", 215)
+		g.AddEdge("NYC", "DC", 225)
+
+		g.Remove("NYC")
+
+		_, ok := g.Weight("Boston", "NYC")
+		assert.False(t, ok)
+		assert.Equal(t, 2, g.Size())
+		assert.ElementsMatch(t, []string{"Boston", "DC"}, g.DepthFirstTraversal())
+	})
+}
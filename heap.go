@@ -1,24 +1,310 @@
-package main
+package datastructures
 
 import (
 	"golang.org/x/exp/constraints"
 )
 
 type HeapInterface[T constraints.Ordered] interface {
-	Peek() T      // returns value at top of heap. O(1)
-	RemoveTop()   // removes element at top of heap. O(logn)
-	Insert(val T) // inserts data val, increases size by 1. O(logn)
-	Empty() bool  // returns whether heap is empty. O(1)
-	Size() int    // returns size of heap. O(1)
-	Sorted() []T  // returns the elements in the heap in sorted order. O(nlogn)
+	Peek() T         // returns value at top of heap. panics if empty. O(1)
+	RemoveTop()      // removes element at top of heap. O(logn)
+	Insert(val T)    // inserts data val, increases size by 1. O(logn)
+	Empty() bool     // returns whether heap is empty. O(1)
+	Size() int       // returns size of heap. O(1)
+	Sorted() []T     // returns the elements in the heap in the order RemoveTop would remove them. O(nlogn)
+	PushPop(val T) T // inserts val then removes and returns the top; faster than Insert followed by RemoveTop. O(logn)
+	Replace(val T) T // removes and returns the top then inserts val; panics if empty. O(logn)
 }
 
-// MaxHeap should implement HeapInterface; write a constructor & methods to complete it
+// MaxHeap implements HeapInterface with the largest value at the top
 type MaxHeap[T constraints.Ordered] struct {
 	arr []Pair[T]
 }
 
-// MinHeap should implement HeapInterface; write a constructor & methods to complete it
+// MinHeap implements HeapInterface with the smallest value at the top
 type MinHeap[T constraints.Ordered] struct {
 	arr []Pair[T]
 }
+
+func NewMaxHeap[T constraints.Ordered]() *MaxHeap[T] {
+	return &MaxHeap[T]{arr: []Pair[T]{}}
+}
+
+func NewMinHeap[T constraints.Ordered]() *MinHeap[T] {
+	return &MinHeap[T]{arr: []Pair[T]{}}
+}
+
+// HeapifyMax builds a MaxHeap from values in O(n). values is not modified.
+func HeapifyMax[T constraints.Ordered](values []T) *MaxHeap[T] {
+	return &MaxHeap[T]{arr: heapify(pairsOf(values), maxFirst[T])}
+}
+
+// HeapifyMin builds a MinHeap from values in O(n). values is not modified.
+func HeapifyMin[T constraints.Ordered](values []T) *MinHeap[T] {
+	return &MinHeap[T]{arr: heapify(pairsOf(values), minFirst[T])}
+}
+
+func (h *MaxHeap[T]) Peek() T {
+	return heapPeek(h.arr).Key
+}
+
+func (h *MaxHeap[T]) RemoveTop() {
+	h.arr = heapRemoveTop(h.arr, maxFirst[T])
+}
+
+func (h *MaxHeap[T]) Insert(val T) {
+	h.arr = heapInsert(h.arr, Pair[T]{Key: val}, maxFirst[T])
+}
+
+func (h *MaxHeap[T]) Empty() bool {
+	return len(h.arr) == 0
+}
+
+func (h *MaxHeap[T]) Size() int {
+	return len(h.arr)
+}
+
+func (h *MaxHeap[T]) Sorted() []T {
+	return heapSorted(h.arr, maxFirst[T])
+}
+
+func (h *MaxHeap[T]) PushPop(val T) T {
+	return heapPushPop(h.arr, Pair[T]{Key: val}, maxFirst[T]).Key
+}
+
+func (h *MaxHeap[T]) Replace(val T) T {
+	return heapReplace(h.arr, Pair[T]{Key: val}, maxFirst[T]).Key
+}
+
+// Merge adds every element of other to h in O(n + m). other is not modified.
+func (h *MaxHeap[T]) Merge(other *MaxHeap[T]) {
+	h.arr = heapify(append(h.arr, other.arr...), maxFirst[T])
+}
+
+func (h *MinHeap[T]) Peek() T {
+	return heapPeek(h.arr).Key
+}
+
+func (h *MinHeap[T]) RemoveTop() {
+	h.arr = heapRemoveTop(h.arr, minFirst[T])
+}
+
+func (h *MinHeap[T]) Insert(val T) {
+	h.arr = heapInsert(h.arr, Pair[T]{Key: val}, minFirst[T])
+}
+
+func (h *MinHeap[T]) Empty() bool {
+	return len(h.arr) == 0
+}
+
+func (h *MinHeap[T]) Size() int {
+	return len(h.arr)
+}
+
+func (h *MinHeap[T]) Sorted() []T {
+	return heapSorted(h.arr, minFirst[T])
+}
+
+func (h *MinHeap[T]) PushPop(val T) T {
+	return heapPushPop(h.arr, Pair[T]{Key: val}, minFirst[T]).Key
+}
+
+func (h *MinHeap[T]) Replace(val T) T {
+	return heapReplace(h.arr, Pair[T]{Key: val}, minFirst[T]).Key
+}
+
+// Merge adds every element of other to h in O(n + m). other is not modified.
+func (h *MinHeap[T]) Merge(other *MinHeap[T]) {
+	h.arr = heapify(append(h.arr, other.arr...), minFirst[T])
+}
+
+// The helpers below hold the heap logic shared by MinHeap and MaxHeap. Their
+// before argument reports whether a belongs closer to the top than b.
+
+func minFirst[T constraints.Ordered](a, b Pair[T]) bool {
+	return a.Key < b.Key
+}
+
+func maxFirst[T constraints.Ordered](a, b Pair[T]) bool {
+	return a.Key > b.Key
+}
+
+func pairsOf[T constraints.Ordered](values []T) []Pair[T] {
+	arr := make([]Pair[T], len(values))
+	for i, val := range values {
+		arr[i] = Pair[T]{Key: val}
+	}
+	return arr
+}
+
+// heapData is the view of a heap's backing array that siftUp and siftDown work on.
+type heapData interface {
+	len() int
+	before(i, j int) bool // whether element i belongs closer to the top than element j
+	swap(i, j int)
+}
+
+// pairSlice is the heapData of MinHeap and MaxHeap.
+type pairSlice[T constraints.Ordered] struct {
+	arr   []Pair[T]
+	order func(a, b Pair[T]) bool
+}
+
+func (p pairSlice[T]) len() int             { return len(p.arr) }
+func (p pairSlice[T]) before(i, j int) bool { return p.order(p.arr[i], p.arr[j]) }
+func (p pairSlice[T]) swap(i, j int)        { p.arr[i], p.arr[j] = p.arr[j], p.arr[i] }
+
+func siftUp(h heapData, i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.before(i, parent) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func siftDown(h heapData, i int) {
+	for {
+		top := i
+		left, right := 2*i+1, 2*i+2
+		if left < h.len() && h.before(left, top) {
+			top = left
+		}
+		if right < h.len() && h.before(right, top) {
+			top = right
+		}
+		if top == i {
+			return
+		}
+		h.swap(i, top)
+		i = top
+	}
+}
+
+// heapify sifts down every internal node from the bottom up, which is O(n).
+func heapify[T constraints.Ordered](arr []Pair[T], before func(a, b Pair[T]) bool) []Pair[T] {
+	h := pairSlice[T]{arr, before}
+	for i := len(arr)/2 - 1; i >= 0; i-- {
+		siftDown(h, i)
+	}
+	return arr
+}
+
+func heapPeek[T constraints.Ordered](arr []Pair[T]) Pair[T] {
+	if len(arr) == 0 {
+		panic("heap: peek on empty heap")
+	}
+	return arr[0]
+}
+
+func heapInsert[T constraints.Ordered](arr []Pair[T], pair Pair[T], before func(a, b Pair[T]) bool) []Pair[T] {
+	arr = append(arr, pair)
+	siftUp(pairSlice[T]{arr, before}, len(arr)-1)
+	return arr
+}
+
+func heapRemoveTop[T constraints.Ordered](arr []Pair[T], before func(a, b Pair[T]) bool) []Pair[T] {
+	if len(arr) == 0 {
+		return arr
+	}
+	last := len(arr) - 1
+	arr[0] = arr[last]
+	arr[last] = Pair[T]{} // drop the reference to Value so it can be garbage collected
+	arr = arr[:last]
+	siftDown(pairSlice[T]{arr, before}, 0)
+	return arr
+}
+
+func heapSorted[T constraints.Ordered](arr []Pair[T], before func(a, b Pair[T]) bool) []T {
+	scratch := append([]Pair[T]{}, arr...)
+	sorted := make([]T, 0, len(arr))
+	for len(scratch) > 0 {
+		sorted = append(sorted, scratch[0].Key)
+		scratch = heapRemoveTop(scratch, before)
+	}
+	return sorted
+}
+
+// heapPushPop returns pair itself when it would sit at the top, leaving arr untouched.
+func heapPushPop[T constraints.Ordered](arr []Pair[T], pair Pair[T], before func(a, b Pair[T]) bool) Pair[T] {
+	if len(arr) == 0 || !before(arr[0], pair) {
+		return pair
+	}
+	top := arr[0]
+	arr[0] = pair
+	siftDown(pairSlice[T]{arr, before}, 0)
+	return top
+}
+
+func heapReplace[T constraints.Ordered](arr []Pair[T], pair Pair[T], before func(a, b Pair[T]) bool) Pair[T] {
+	top := heapPeek(arr)
+	arr[0] = pair
+	siftDown(pairSlice[T]{arr, before}, 0)
+	return top
+}
+
+// heapItem is an element of an indexedHeap. index always holds the item's
+// current position in the heap array, or -1 once it has been removed.
+type heapItem[T constraints.Ordered] struct {
+	Pair[T]
+	seq   uint64
+	index int
+}
+
+// indexedHeap is a MinHeap or MaxHeap (depending on order) that keeps each
+// item's index in sync as it sifts, so items can be updated or removed by
+// reference in O(logn).
+type indexedHeap[T constraints.Ordered] struct {
+	arr   []*heapItem[T]
+	order func(a, b *heapItem[T]) bool
+}
+
+func (h *indexedHeap[T]) len() int             { return len(h.arr) }
+func (h *indexedHeap[T]) before(i, j int) bool { return h.order(h.arr[i], h.arr[j]) }
+func (h *indexedHeap[T]) swap(i, j int) {
+	h.arr[i], h.arr[j] = h.arr[j], h.arr[i]
+	h.arr[i].index = i
+	h.arr[j].index = j
+}
+
+// contains reports whether item is currently stored in h.
+func (h *indexedHeap[T]) contains(item *heapItem[T]) bool {
+	return item != nil && item.index >= 0 && item.index < len(h.arr) && h.arr[item.index] == item
+}
+
+func (h *indexedHeap[T]) peek() *heapItem[T] {
+	if len(h.arr) == 0 {
+		panic("heap: peek on empty heap")
+	}
+	return h.arr[0]
+}
+
+func (h *indexedHeap[T]) push(item *heapItem[T]) {
+	item.index = len(h.arr)
+	h.arr = append(h.arr, item)
+	siftUp(h, item.index)
+}
+
+// remove takes the item at index i out of the heap and returns it.
+func (h *indexedHeap[T]) remove(i int) *heapItem[T] {
+	item := h.arr[i]
+	last := len(h.arr) - 1
+	if i != last {
+		h.swap(i, last)
+	}
+	h.arr[last] = nil
+	h.arr = h.arr[:last]
+	if i != last {
+		h.fix(i)
+	}
+	item.index = -1
+	return item
+}
+
+// fix restores heap order after the item at index i changed its key.
+func (h *indexedHeap[T]) fix(i int) {
+	item := h.arr[i]
+	siftUp(h, i)
+	siftDown(h, item.index)
+}
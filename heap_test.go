@@ -0,0 +1,227 @@
+package datastructures
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ HeapInterface[int] = (*MaxHeap[int])(nil)
+var _ HeapInterface[int] = (*MinHeap[int])(nil)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for NewMinHeap and NewMaxHeap */
+/*--------------------------------------------------------------------------------------------------*/
+func TestNewHeap(t *testing.T) {
+
+	// Happy Path
+	t.Run("New heaps are empty", func(t *testing.T) {
+		minHeap := NewMinHeap[int]()
+		maxHeap := NewMaxHeap[string]()
+
+		assert.True(t, minHeap.Empty())
+		assert.Equal(t, 0, minHeap.Size())
+		assert.True(t, maxHeap.Empty())
+		assert.Equal(t, 0, maxHeap.Size())
+	})
+
+	// Edge Case
+	t.Run("Peek on an empty heap panics", func(t *testing.T) {
+		assert.Panics(t, func() { NewMinHeap[int]().Peek() })
+		assert.Panics(t, func() { NewMaxHeap[int]().Peek() })
+	})
+
+	// Edge Case
+	t.Run("RemoveTop on an empty heap does nothing", func(t *testing.T) {
+		h := NewMinHeap[int]()
+
+		h.RemoveTop()
+
+		assert.True(t, h.Empty())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Insert, Peek and RemoveTop */
+/*--------------------------------------------------------------------------------------------------*/
+func TestHeap_InsertPeekRemoveTop(t *testing.T) {
+
+	// Happy Path
+	t.Run("MinHeap keeps the smallest value on top", func(t *testing.T) {
+		h := NewMinHeap[int]()
+		for _, val := range []int{5, 3, 8, 1, 9, 2} {
+			h.Insert(val)
+		}
+
+		assert.Equal(t, 6, h.Size())
+		assert.Equal(t, 1, h.Peek())
+		h.RemoveTop()
+		assert.Equal(t, 2, h.Peek())
+		assert.Equal(t, 5, h.Size())
+	})
+
+	// Happy Path
+	t.Run("MaxHeap keeps the largest value on top", func(t *testing.T) {
+		h := NewMaxHeap[int]()
+		for _, val := range []int{5, 3, 8, 1, 9, 2} {
+			h.Insert(val)
+		}
+
+		assert.Equal(t, 9, h.Peek())
+		h.RemoveTop()
+		assert.Equal(t, 8, h.Peek())
+	})
+
+	// Edge Case
+	t.Run("Duplicate values are all kept", func(t *testing.T) {
+		h := NewMinHeap[int]()
+		h.Insert(4)
+		h.Insert(4)
+		h.Insert(4)
+
+		assert.Equal(t, []int{4, 4, 4}, h.Sorted())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Sorted */
+/*--------------------------------------------------------------------------------------------------*/
+func TestHeap_Sorted(t *testing.T) {
+
+	// Happy Path
+	t.Run("Sorted returns removal order without draining the heap", func(t *testing.T) {
+		values := rand.New(rand.NewSource(1)).Perm(200)
+		minHeap := NewMinHeap[int]()
+		maxHeap := NewMaxHeap[int]()
+		for _, val := range values {
+			minHeap.Insert(val)
+			maxHeap.Insert(val)
+		}
+
+		ascending := append([]int{}, values...)
+		sort.Ints(ascending)
+		descending := append([]int{}, ascending...)
+		sort.Sort(sort.Reverse(sort.IntSlice(descending)))
+
+		assert.Equal(t, ascending, minHeap.Sorted())
+		assert.Equal(t, descending, maxHeap.Sorted())
+		assert.Equal(t, 200, minHeap.Size())
+		assert.Equal(t, 200, maxHeap.Size())
+	})
+
+	// Edge Case
+	t.Run("Sorted on an empty heap is empty", func(t *testing.T) {
+		assert.Empty(t, NewMaxHeap[int]().Sorted())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for HeapifyMin and HeapifyMax */
+/*--------------------------------------------------------------------------------------------------*/
+func TestHeapify(t *testing.T) {
+
+	// Happy Path
+	t.Run("Heapify builds a valid heap", func(t *testing.T) {
+		values := []int{7, 2, 9, 4, 1, 8, 3}
+
+		assert.Equal(t, []int{1, 2, 3, 4, 7, 8, 9}, HeapifyMin(values).Sorted())
+		assert.Equal(t, []int{9, 8, 7, 4, 3, 2, 1}, HeapifyMax(values).Sorted())
+	})
+
+	// Edge Case
+	t.Run("Heapify does not modify the input", func(t *testing.T) {
+		values := []int{3, 1, 2}
+
+		HeapifyMin(values)
+
+		assert.Equal(t, []int{3, 1, 2}, values)
+	})
+
+	// Edge Case
+	t.Run("Heapify of no values is empty", func(t *testing.T) {
+		assert.True(t, HeapifyMin([]int{}).Empty())
+		assert.True(t, HeapifyMax[int](nil).Empty())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Merge */
+/*--------------------------------------------------------------------------------------------------*/
+func TestHeap_Merge(t *testing.T) {
+
+	// Happy Path
+	t.Run("Merge combines both heaps", func(t *testing.T) {
+		a := HeapifyMin([]int{5, 1, 9})
+		b := HeapifyMin([]int{4, 2, 8})
+
+		a.Merge(b)
+
+		assert.Equal(t, []int{1, 2, 4, 5, 8, 9}, a.Sorted())
+		assert.Equal(t, []int{2, 4, 8}, b.Sorted())
+	})
+
+	// Happy Path
+	t.Run("Merge on max heaps", func(t *testing.T) {
+		a := HeapifyMax([]int{5, 1})
+		b := HeapifyMax([]int{7})
+
+		a.Merge(b)
+
+		assert.Equal(t, 7, a.Peek())
+		assert.Equal(t, 3, a.Size())
+	})
+
+	// Edge Case
+	t.Run("Merging an empty heap changes nothing", func(t *testing.T) {
+		a := HeapifyMin([]int{3, 1})
+
+		a.Merge(NewMinHeap[int]())
+
+		assert.Equal(t, []int{1, 3}, a.Sorted())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for PushPop and Replace */
+/*--------------------------------------------------------------------------------------------------*/
+func TestHeap_PushPopReplace(t *testing.T) {
+
+	// Happy Path
+	t.Run("PushPop returns the new value when it would be on top", func(t *testing.T) {
+		h := HeapifyMin([]int{5, 7})
+
+		assert.Equal(t, 1, h.PushPop(1))
+		assert.Equal(t, []int{5, 7}, h.Sorted())
+	})
+
+	// Happy Path
+	t.Run("PushPop returns the old top otherwise", func(t *testing.T) {
+		h := HeapifyMax([]int{5, 7})
+
+		assert.Equal(t, 7, h.PushPop(6))
+		assert.Equal(t, []int{6, 5}, h.Sorted())
+	})
+
+	// Edge Case
+	t.Run("PushPop on an empty heap returns the value", func(t *testing.T) {
+		h := NewMinHeap[int]()
+
+		assert.Equal(t, 3, h.PushPop(3))
+		assert.True(t, h.Empty())
+	})
+
+	// Happy Path
+	t.Run("Replace always removes the old top", func(t *testing.T) {
+		h := HeapifyMin([]int{5, 7})
+
+		assert.Equal(t, 5, h.Replace(1))
+		assert.Equal(t, []int{1, 7}, h.Sorted())
+	})
+
+	// Edge Case
+	t.Run("Replace on an empty heap panics", func(t *testing.T) {
+		assert.Panics(t, func() { NewMaxHeap[int]().Replace(1) })
+	})
+}
@@ -1,26 +1,184 @@
-package main
+package datastructures
 
 import (
+	"hash/fnv"
+	"math"
+	"reflect"
+
 	"golang.org/x/exp/constraints"
 )
 
 type MapInterface[T constraints.Ordered, V any] interface {
-	hash(key T) int      // helper function to assign val to an index in the underlying array
-	resize()             // helper function to double the size of the underlying array
-	Contains(key T) bool // returns whether map contains element key. O(1)
-	Get(key T)           // returns value for key in map. throws error if not present. O(1)
-	Set(key T, val V)    // inserts key-val pair in map if key is not present, increases size by 1. set key's value to val if present. O(1)
-	Removes(key T)       // removes key from map if present, decreases size by 1. O(1)
-	Empty() bool         // returns whether map is empty. O(1)
-	Size() int           // returns number of elements in map. O(1)
-	Values() []any       // returns all values in the map.
-	Keys() []T           // returns all keys in the map.
-	Objects() []Pair[T]  // returns all key-value pairs in the map.
-}
-
-// Map should implement MapInterface; write a constructor & methods to complete it
+	hash(key T) int            // helper function to assign val to an index in the underlying array
+	resize()                   // helper function to double the size of the underlying array
+	Contains(key T) bool       // returns whether map contains element key. O(1)
+	Get(key T) (V, bool)       // returns value for key in map and whether key was present; the zero value if not. O(1)
+	MustGet(key T) V           // returns value for key in map. panics if not present. O(1)
+	Set(key T, val V)          // inserts key-val pair in map if key is not present, increases size by 1. set key's value to val if present. O(1)
+	Delete(key T) bool         // removes key from map if present, decreases size by 1. returns whether key was removed. O(1)
+	Empty() bool               // returns whether map is empty. O(1)
+	Size() int                 // returns number of elements in map. O(1)
+	Values() []V               // returns all values in the map.
+	Keys() []T                 // returns all keys in the map.
+	Objects() []MapEntry[T, V] // returns all key-value pairs in the map.
+}
+
+// MapEntry is a key-value pair whose value keeps the map's value type.
+type MapEntry[T constraints.Ordered, V any] struct {
+	Key   T
+	Value V
+}
+
+// Map implements MapInterface as an array of BinarySearchTree buckets that doubles once maxFill is exceeded
 type Map[T constraints.Ordered, V any] struct {
 	arr     []BinarySearchTree[T]
 	maxFill float32 // value in (0, 1] indicating the maximum allowable ratio of elements to arr before arr is resized
 	size    int
 }
+
+const (
+	defaultCapacity = 16
+	defaultMaxFill  = 0.75
+)
+
+// NewMap returns an empty map with initialCapacity buckets. A capacity below 1
+// falls back to 16 buckets and a maxFill outside (0, 1] falls back to 0.75.
+func NewMap[T constraints.Ordered, V any](initialCapacity int, maxFill float32) *Map[T, V] {
+	if initialCapacity < 1 {
+		initialCapacity = defaultCapacity
+	}
+	if maxFill <= 0 || maxFill > 1 {
+		maxFill = defaultMaxFill
+	}
+	return &Map[T, V]{
+		arr:     make([]BinarySearchTree[T], initialCapacity),
+		maxFill: maxFill,
+		size:    0,
+	}
+}
+
+func (m *Map[T, V]) hash(key T) int {
+	return int(hashKey(key) % uint64(len(m.arr)))
+}
+
+func (m *Map[T, V]) resize() {
+	old := m.arr
+	m.arr = make([]BinarySearchTree[T], 2*len(old))
+	for i := range old {
+		for _, pair := range old[i].PreOrderTraversal() {
+			m.arr[m.hash(pair.Key)].Insert(pair.Key, pair.Value)
+		}
+	}
+}
+
+func (m *Map[T, V]) Contains(key T) bool {
+	return m.arr[m.hash(key)].Contains(key)
+}
+
+func (m *Map[T, V]) Get(key T) (V, bool) {
+	bucket := &m.arr[m.hash(key)]
+	if !bucket.Contains(key) {
+		var zero V
+		return zero, false
+	}
+	val, _ := bucket.Lookup(key).(V) // a nil stored for an interface V comes back as the zero value
+	return val, true
+}
+
+func (m *Map[T, V]) MustGet(key T) V {
+	val, ok := m.Get(key)
+	if !ok {
+		panic("map: key not present")
+	}
+	return val
+}
+
+func (m *Map[T, V]) Set(key T, val V) {
+	bucket := &m.arr[m.hash(key)]
+	if bucket.Contains(key) {
+		bucket.Update(key, val)
+		return
+	}
+	bucket.Insert(key, val)
+	m.size++
+	if float32(m.size)/float32(len(m.arr)) > m.maxFill {
+		m.resize()
+	}
+}
+
+func (m *Map[T, V]) Delete(key T) bool {
+	bucket := &m.arr[m.hash(key)]
+	if !bucket.Contains(key) {
+		return false
+	}
+	bucket.Remove(key)
+	m.size--
+	return true
+}
+
+func (m *Map[T, V]) Empty() bool {
+	return m.size == 0
+}
+
+func (m *Map[T, V]) Size() int {
+	return m.size
+}
+
+func (m *Map[T, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	for _, pair := range m.Objects() {
+		values = append(values, pair.Value)
+	}
+	return values
+}
+
+func (m *Map[T, V]) Keys() []T {
+	keys := make([]T, 0, m.size)
+	for _, pair := range m.Objects() {
+		keys = append(keys, pair.Key)
+	}
+	return keys
+}
+
+func (m *Map[T, V]) Objects() []MapEntry[T, V] {
+	entries := make([]MapEntry[T, V], 0, m.size)
+	for i := range m.arr {
+		for _, pair := range m.arr[i].InOrderTraversal() {
+			val, _ := pair.Value.(V)
+			entries = append(entries, MapEntry[T, V]{Key: pair.Key, Value: val})
+		}
+	}
+	return entries
+}
+
+// hashKey hashes any ordered key, including named types such as
+// `type ID int`, by switching on the underlying kind.
+func hashKey[T constraints.Ordered](key T) uint64 {
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f == 0 { // -0.0 == 0.0, so both must land in the same bucket
+			f = 0
+		}
+		return mix64(math.Float64bits(f))
+	default: // reflect.String
+		h := fnv.New64a()
+		h.Write([]byte(v.String()))
+		return h.Sum64()
+	}
+}
+
+// mix64 is the splitmix64 finalizer; it spreads sequential keys across buckets.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,248 @@
+package datastructures
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ MapInterface[string, int] = (*Map[string, int])(nil)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for NewMap */
+/*--------------------------------------------------------------------------------------------------*/
+func TestNewMap(t *testing.T) {
+
+	// Happy Path
+	t.Run("New map is empty", func(t *testing.T) {
+		m := NewMap[string, int](8, 0.5)
+
+		assert.True(t, m.Empty())
+		assert.Equal(t, 0, m.Size())
+		assert.Equal(t, 8, len(m.arr))
+		assert.Equal(t, float32(0.5), m.maxFill)
+	})
+
+	// Edge Case
+	t.Run("Invalid capacity and max fill fall back to defaults", func(t *testing.T) {
+		m := NewMap[string, int](0, 1.5)
+
+		assert.Equal(t, defaultCapacity, len(m.arr))
+		assert.Equal(t, float32(defaultMaxFill), m.maxFill)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for hash */
+/*--------------------------------------------------------------------------------------------------*/
+func TestMap_hash(t *testing.T) {
+
+	// Happy Path
+	t.Run("Hash stays within bucket range for every key type", func(t *testing.T) {
+		ints := NewMap[int, bool](7, 0.75)
+		floats := NewMap[float64, bool](7, 0.75)
+		strings := NewMap[string, bool](7, 0.75)
+
+		for i := -50; i < 50; i++ {
+			assert.GreaterOrEqual(t, ints.hash(i), 0)
+			assert.Less(t, ints.hash(i), 7)
+			assert.GreaterOrEqual(t, floats.hash(float64(i)/3), 0)
+			assert.Less(t, floats.hash(float64(i)/3), 7)
+		}
+		for _, s := range []string{"", "a", "uber", "data structures"} {
+			assert.GreaterOrEqual(t, strings.hash(s), 0)
+			assert.Less(t, strings.hash(s), 7)
+		}
+	})
+
+	// Edge Case
+	t.Run("Negative zero and zero hash to the same bucket", func(t *testing.T) {
+		m := NewMap[float64, string](16, 0.75)
+		negZero := 0.0
+		negZero = -negZero
+
+		m.Set(0.0, "zero")
+		m.Set(negZero, "negative zero")
+
+		assert.Equal(t, 1, m.Size())
+		assert.Equal(t, "negative zero", m.MustGet(0.0))
+	})
+
+	// Edge Case
+	t.Run("Named key types are hashed by their underlying kind", func(t *testing.T) {
+		type riderID int
+		m := NewMap[riderID, string](4, 0.75)
+
+		m.Set(riderID(42), "Alice")
+
+		assert.True(t, m.Contains(riderID(42)))
+		assert.Equal(t, "Alice", m.MustGet(riderID(42)))
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Set and Get */
+/*--------------------------------------------------------------------------------------------------*/
+func TestMap_SetAndGet(t *testing.T) {
+
+	// Happy Path
+	t.Run("Set inserts new keys", func(t *testing.T) {
+		m := NewMap[string, int](4, 0.75)
+
+		m.Set("a", 1)
+		m.Set("b", 2)
+
+		assert.Equal(t, 2, m.Size())
+		assert.Equal(t, 1, m.MustGet("a"))
+		assert.Equal(t, 2, m.MustGet("b"))
+	})
+
+	// Happy Path
+	t.Run("Set overwrites existing keys without growing", func(t *testing.T) {
+		m := NewMap[string, int](4, 0.75)
+
+		m.Set("a", 1)
+		m.Set("a", 10)
+
+		assert.Equal(t, 1, m.Size())
+		assert.Equal(t, 10, m.MustGet("a"))
+	})
+
+	// Happy Path
+	t.Run("Get reports whether the key is present", func(t *testing.T) {
+		m := NewMap[string, int](4, 0.75)
+		m.Set("a", 1)
+
+		val, ok := m.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+
+		val, ok = m.Get("missing")
+		assert.False(t, ok)
+		assert.Equal(t, 0, val)
+	})
+
+	// Edge Case
+	t.Run("Get returns the zero value for a stored nil", func(t *testing.T) {
+		m := NewMap[string, error](4, 0.75)
+		m.Set("a", nil)
+
+		val, ok := m.Get("a")
+		assert.True(t, ok)
+		assert.Nil(t, val)
+	})
+
+	// Edge Case
+	t.Run("MustGet on a missing key panics", func(t *testing.T) {
+		m := NewMap[string, int](4, 0.75)
+
+		assert.Panics(t, func() { m.MustGet("missing") })
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for resize */
+/*--------------------------------------------------------------------------------------------------*/
+func TestMap_resize(t *testing.T) {
+
+	// Happy Path
+	t.Run("Exceeding max fill doubles the bucket array", func(t *testing.T) {
+		m := NewMap[int, int](4, 0.5)
+
+		m.Set(1, 1)
+		m.Set(2, 2)
+		assert.Equal(t, 4, len(m.arr))
+
+		m.Set(3, 3)
+		assert.Equal(t, 8, len(m.arr))
+	})
+
+	// Happy Path
+	t.Run("All entries survive repeated resizes", func(t *testing.T) {
+		m := NewMap[int, int](1, 0.75)
+
+		for i := 0; i < 1000; i++ {
+			m.Set(i, i*i)
+		}
+
+		assert.Equal(t, 1000, m.Size())
+		assert.LessOrEqual(t, float32(m.Size())/float32(len(m.arr)), float32(0.75))
+		for i := 0; i < 1000; i++ {
+			assert.Equal(t, i*i, m.MustGet(i))
+		}
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Delete */
+/*--------------------------------------------------------------------------------------------------*/
+func TestMap_Delete(t *testing.T) {
+
+	// Happy Path
+	t.Run("Delete removes the key and reports it", func(t *testing.T) {
+		m := NewMap[string, int](4, 0.75)
+		m.Set("a", 1)
+		m.Set("b", 2)
+
+		assert.True(t, m.Delete("a"))
+
+		assert.False(t, m.Contains("a"))
+		assert.True(t, m.Contains("b"))
+		assert.Equal(t, 1, m.Size())
+	})
+
+	// Edge Case
+	t.Run("Delete on a missing key reports false", func(t *testing.T) {
+		m := NewMap[string, int](4, 0.75)
+		m.Set("a", 1)
+
+		assert.False(t, m.Delete("missing"))
+
+		assert.Equal(t, 1, m.Size())
+	})
+
+	// Edge Case
+	t.Run("Removing every key leaves an empty map", func(t *testing.T) {
+		m := NewMap[int, int](2, 0.75)
+		for i := 0; i < 50; i++ {
+			m.Set(i, i)
+		}
+		for i := 0; i < 50; i++ {
+			m.Delete(i)
+		}
+
+		assert.True(t, m.Empty())
+		assert.Empty(t, m.Keys())
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Keys, Values and Objects */
+/*--------------------------------------------------------------------------------------------------*/
+func TestMap_Enumeration(t *testing.T) {
+
+	// Happy Path
+	t.Run("Keys, Values and Objects report every entry", func(t *testing.T) {
+		m := NewMap[string, int](2, 0.75)
+		m.Set("a", 1)
+		m.Set("b", 2)
+		m.Set("c", 3)
+
+		keys := m.Keys()
+		sort.Strings(keys)
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+
+		assert.ElementsMatch(t, []int{1, 2, 3}, m.Values())
+		assert.ElementsMatch(t, []MapEntry[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}, m.Objects())
+	})
+
+	// Edge Case
+	t.Run("Enumerating an empty map returns empty slices", func(t *testing.T) {
+		m := NewMap[string, int](4, 0.75)
+
+		assert.Empty(t, m.Keys())
+		assert.Empty(t, m.Values())
+		assert.Empty(t, m.Objects())
+	})
+}
@@ -0,0 +1,101 @@
+package datastructures
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// MSTAlgorithm selects how MinimumSpanningTree grows its tree.
+type MSTAlgorithm int
+
+const (
+	Kruskal MSTAlgorithm = iota // O(e * loge); adds the cheapest edges that join two trees, using a DisjointSet
+	Prim                        // O(e * logn); grows one tree from its cheapest outgoing edge, using a PriorityQueue
+)
+
+// MinimumSpanningTree returns the edges of a minimum spanning tree and their
+// total weight. A disconnected graph gets a minimum spanning forest with one
+// tree per connected component, so there are always Size() minus the number of
+// components edges. Every edge has From < To; self-loops never appear.
+func (g *Graph[T]) MinimumSpanningTree(algorithm MSTAlgorithm) ([]Edge[T], float64) {
+	if algorithm == Prim {
+		return g.view().prim()
+	}
+	return g.view().kruskal()
+}
+
+func (v graphView[T]) kruskal() ([]Edge[T], float64) {
+	sets := NewDisjointSet[T]()
+	edges := []Edge[T]{}
+	for _, from := range v.nodeList() {
+		sets.MakeSet(from)
+		for _, to := range v.neighborList(from) {
+			if from < to {
+				edges = append(edges, Edge[T]{From: from, To: to, Weight: v.weights[from][to]})
+			}
+		}
+	}
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].Weight < edges[j].Weight })
+
+	tree := []Edge[T]{}
+	total := 0.0
+	for _, edge := range edges {
+		// an edge inside one tree would close a cycle
+		if sets.Union(edge.From, edge.To) {
+			tree = append(tree, edge)
+			total += edge.Weight
+		}
+	}
+	return tree, total
+}
+
+func (v graphView[T]) prim() ([]Edge[T], float64) {
+	inTree := make(map[T]struct{}, len(v.nodes))
+	best := make(map[T]Edge[T]) // cheapest known edge joining each node to the tree
+	queued := make(map[T]PQHandle[float64, T])
+	pq := NewStablePriorityQueue[float64, T](LowestFirst)
+	tree := []Edge[T]{}
+	total := 0.0
+
+	// every root not yet reached starts the next tree of the forest
+	for _, root := range v.nodeList() {
+		if _, ok := inTree[root]; ok {
+			continue
+		}
+		pq.Enqueue(0, root)
+		for !pq.Empty() {
+			_, node := pq.Dequeue()
+			inTree[node] = struct{}{}
+			if edge, ok := best[node]; ok {
+				tree = append(tree, undirectedEdge(edge.From, edge.To, edge.Weight))
+				total += edge.Weight
+			}
+
+			for _, neighbor := range v.neighborList(node) {
+				if _, ok := inTree[neighbor]; ok {
+					continue
+				}
+				weight := v.weights[node][neighbor]
+				if edge, ok := best[neighbor]; ok && edge.Weight <= weight {
+					continue
+				}
+				best[neighbor] = Edge[T]{From: node, To: neighbor, Weight: weight}
+				if handle, ok := queued[neighbor]; ok {
+					pq.UpdatePriority(handle, weight)
+				} else {
+					queued[neighbor] = pq.Enqueue(weight, neighbor)
+				}
+			}
+		}
+	}
+	return tree, total
+}
+
+// undirectedEdge orders an edge's ends so the same edge is always written the same way.
+func undirectedEdge[T constraints.Ordered](a T, b T, weight float64) Edge[T] {
+	if b < a {
+		a, b = b, a
+	}
+	return Edge[T]{From: a, To: b, Weight: weight}
+}
@@ -0,0 +1,92 @@
+package datastructures
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clrsMSTGraph is the minimum spanning tree example from CLRS; its MST weighs 37.
+func clrsMSTGraph() *Graph[string] {
+	g := NewOrderedGraph[string](KeyOrder)
+	for _, edge := range []Edge[string]{
+		{"a", "b", 4}, {"a", "h", 8}, {"b", "c", 8}, {"b", "h", 11}, {"c", "d", 7},
+		{"c", "f", 4}, {"c", "i", 2}, {"d", "e", 9}, {"d", "f", 14}, {"e", "f", 10},
+		{"f", "g", 2}, {"g", "h", 1}, {"g", "i", 6}, {"h", "i", 7},
+	} {
+		g.AddEdge(edge.From, edge.To, edge.Weight)
+	}
+	return g
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for MinimumSpanningTree */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_MinimumSpanningTree(t *testing.T) {
+
+	// Happy Path
+	t.Run("Kruskal and Prim find the textbook tree", func(t *testing.T) {
+		for _, algorithm := range []MSTAlgorithm{Kruskal, Prim} {
+			edges, total := clrsMSTGraph().MinimumSpanningTree(algorithm)
+
+			assert.Equal(t, 37.0, total)
+			assert.Len(t, edges, 8)
+			assert.Contains(t, edges, Edge[string]{From: "g", To: "h", Weight: 1})
+			assert.NotContains(t, edges, Edge[string]{From: "d", To: "f", Weight: 14})
+		}
+	})
+
+	// Happy Path
+	t.Run("A disconnected graph gets a spanning forest", func(t *testing.T) {
+		want := []Edge[string]{
+			{From: "NYC", To: "Philly", Weight: 95},
+			{From: "DC", To: "Philly", Weight: 140},
+			{From: "Boston", To: "NYC", Weight: 215},
+			{From: "Boston", To: "Portland", Weight: 110},
+		}
+
+		for _, algorithm := range []MSTAlgorithm{Kruskal, Prim} {
+			edges, total := cityGraph().MinimumSpanningTree(algorithm)
+
+			assert.ElementsMatch(t, want, edges)
+			assert.Equal(t, 560.0, total)
+		}
+	})
+
+	// Happy Path
+	t.Run("Both algorithms agree on random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(3))
+		for trial := 0; trial < 20; trial++ {
+			g := NewGraph[int]()
+			for i := 0; i < 40; i++ {
+				g.Insert(Pair[int]{Key: i}, nil)
+			}
+			for i := 0; i < 70; i++ {
+				g.AddEdge(rng.Intn(40), rng.Intn(40), float64(rng.Intn(50)))
+			}
+
+			kruskal, kruskalTotal := g.MinimumSpanningTree(Kruskal)
+			prim, primTotal := g.MinimumSpanningTree(Prim)
+
+			assert.Equal(t, kruskalTotal, primTotal)
+			assert.Len(t, prim, len(kruskal))
+			assert.Len(t, kruskal, g.Size()-len(g.ConnectedComponents()))
+		}
+	})
+
+	// Edge Case
+	t.Run("Self-loops and empty graphs have no tree edges", func(t *testing.T) {
+		g := NewGraph[int]()
+		g.AddEdge(1, 1, 5)
+
+		for _, algorithm := range []MSTAlgorithm{Kruskal, Prim} {
+			edges, total := g.MinimumSpanningTree(algorithm)
+			assert.Empty(t, edges)
+			assert.Equal(t, 0.0, total)
+
+			edges, _ = NewGraph[int]().MinimumSpanningTree(algorithm)
+			assert.Empty(t, edges)
+		}
+	})
+}
@@ -1,18 +1,122 @@
-package main
+package datastructures
 
 import (
 	"golang.org/x/exp/constraints"
 )
 
 type PriorityQueueInterface[T constraints.Ordered, V any] interface {
-	Front() Pair[T]            // returns first item in queue. O(1)
-	Enqueue(priority T, val V) // adds val to queue with priority, increases size by 1. O(1)
-	Dequeue()                  // removes the highest priority item form queue, decreases size by 1. O(1)
-	Empty() bool               // returns whether queue is empty. O(1)
-	Size() int                 // returns number of elements in queue. O(1)
+	Front() (T, V)                                         // returns priority and value of first item in queue. panics if empty. O(1)
+	Enqueue(priority T, val V) PQHandle[T, V]              // adds val to queue with priority, increases size by 1. returns a handle to the item. O(logn)
+	Dequeue() (T, V)                                       // removes and returns the first item in queue, decreases size by 1. panics if empty. O(logn)
+	UpdatePriority(handle PQHandle[T, V], priority T) bool // changes the priority of a queued item. returns whether the item was in the queue. O(logn)
+	Remove(handle PQHandle[T, V]) bool                     // removes a queued item, decreases size by 1. returns whether the item was in the queue. O(logn)
+	Contains(handle PQHandle[T, V]) bool                   // returns whether the item is still in the queue. O(1)
+	Empty() bool                                           // returns whether queue is empty. O(1)
+	Size() int                                             // returns number of elements in queue. O(1)
 }
 
-// PriorityQueue should implement PriorityQueueInterface; write a constructor & methods to complete it
+// PriorityOrder selects whether a PriorityQueue serves its largest or smallest priority first.
+type PriorityOrder int
+
+const (
+	HighestFirst PriorityOrder = iota // max-priority queue: larger priorities are dequeued first
+	LowestFirst                       // min-priority queue: smaller priorities are dequeued first
+)
+
+// PQHandle refers to an item enqueued in a PriorityQueue. It stays valid until
+// the item is dequeued or removed; the zero PQHandle refers to no item.
+type PQHandle[T constraints.Ordered, V any] struct {
+	item *heapItem[T]
+}
+
+// PriorityQueue implements PriorityQueueInterface on an indexedHeap whose items
+// hold the priority as Key and the payload as Value.
 type PriorityQueue[T constraints.Ordered, V any] struct {
-	heap MaxHeap[T]
+	heap *indexedHeap[T]
+	seq  uint64 // number of items ever enqueued; stamps each item so stable queues can break ties
+}
+
+func NewPriorityQueue[T constraints.Ordered, V any](order PriorityOrder) *PriorityQueue[T, V] {
+	before := priorityBefore[T](order)
+	return &PriorityQueue[T, V]{
+		heap: &indexedHeap[T]{
+			arr: []*heapItem[T]{},
+			order: func(a, b *heapItem[T]) bool {
+				return before(a.Pair, b.Pair)
+			},
+		},
+	}
+}
+
+// NewStablePriorityQueue returns a PriorityQueue that dequeues items with equal
+// priority in the order they were enqueued. An item keeps its place among ties
+// when UpdatePriority is called on it.
+func NewStablePriorityQueue[T constraints.Ordered, V any](order PriorityOrder) *PriorityQueue[T, V] {
+	before := priorityBefore[T](order)
+	return &PriorityQueue[T, V]{
+		heap: &indexedHeap[T]{
+			arr: []*heapItem[T]{},
+			order: func(a, b *heapItem[T]) bool {
+				if a.Key == b.Key {
+					return a.seq < b.seq
+				}
+				return before(a.Pair, b.Pair)
+			},
+		},
+	}
+}
+
+func priorityBefore[T constraints.Ordered](order PriorityOrder) func(a, b Pair[T]) bool {
+	if order == LowestFirst {
+		return minFirst[T]
+	}
+	return maxFirst[T]
+}
+
+func (pq *PriorityQueue[T, V]) Front() (T, V) {
+	front := pq.heap.peek()
+	val, _ := front.Value.(V)
+	return front.Key, val
+}
+
+func (pq *PriorityQueue[T, V]) Enqueue(priority T, val V) PQHandle[T, V] {
+	item := &heapItem[T]{Pair: Pair[T]{Key: priority, Value: val}, seq: pq.seq}
+	pq.seq++
+	pq.heap.push(item)
+	return PQHandle[T, V]{item: item}
+}
+
+func (pq *PriorityQueue[T, V]) Dequeue() (T, V) {
+	priority, val := pq.Front()
+	pq.heap.remove(0)
+	return priority, val
+}
+
+func (pq *PriorityQueue[T, V]) UpdatePriority(handle PQHandle[T, V], priority T) bool {
+	if !pq.Contains(handle) {
+		return false
+	}
+	handle.item.Key = priority
+	pq.heap.fix(handle.item.index)
+	return true
+}
+
+func (pq *PriorityQueue[T, V]) Remove(handle PQHandle[T, V]) bool {
+	if !pq.Contains(handle) {
+		return false
+	}
+	pq.heap.remove(handle.item.index)
+	return true
+}
+
+func (pq *PriorityQueue[T, V]) Contains(handle PQHandle[T, V]) bool {
+	return pq.heap.contains(handle.item)
+}
+
+func (pq *PriorityQueue[T, V]) Empty() bool {
+	return len(pq.heap.arr) == 0
+}
+
+func (pq *PriorityQueue[T, V]) Size() int {
+	return len(pq.heap.arr)
 }
@@ -0,0 +1,301 @@
+package datastructures
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ PriorityQueueInterface[int, string] = (*PriorityQueue[int, string])(nil)
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for NewPriorityQueue */
+/*--------------------------------------------------------------------------------------------------*/
+func TestNewPriorityQueue(t *testing.T) {
+
+	// Happy Path
+	t.Run("New priority queue is empty", func(t *testing.T) {
+		pq := NewPriorityQueue[int, string](HighestFirst)
+
+		assert.True(t, pq.Empty())
+		assert.Equal(t, 0, pq.Size())
+	})
+
+	// Edge Case
+	t.Run("Front and Dequeue on an empty queue panic", func(t *testing.T) {
+		pq := NewPriorityQueue[int, string](LowestFirst)
+
+		assert.Panics(t, func() { pq.Front() })
+		assert.Panics(t, func() { pq.Dequeue() })
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Enqueue, Front and Dequeue */
+/*--------------------------------------------------------------------------------------------------*/
+func TestPriorityQueue_EnqueueDequeue(t *testing.T) {
+
+	// Happy Path
+	t.Run("HighestFirst serves the largest priority first", func(t *testing.T) {
+		pq := NewPriorityQueue[int, string](HighestFirst)
+		pq.Enqueue(2, "medium")
+		pq.Enqueue(5, "urgent")
+		pq.Enqueue(1, "low")
+
+		priority, val := pq.Front()
+		assert.Equal(t, 5, priority)
+		assert.Equal(t, "urgent", val)
+		assert.Equal(t, 3, pq.Size())
+
+		_, val = pq.Dequeue()
+		assert.Equal(t, "urgent", val)
+		_, val = pq.Dequeue()
+		assert.Equal(t, "medium", val)
+		_, val = pq.Dequeue()
+		assert.Equal(t, "low", val)
+		assert.True(t, pq.Empty())
+	})
+
+	// Happy Path
+	t.Run("LowestFirst serves the smallest priority first", func(t *testing.T) {
+		pq := NewPriorityQueue[float64, int](LowestFirst)
+		pq.Enqueue(3.5, 35)
+		pq.Enqueue(0.5, 5)
+		pq.Enqueue(2.0, 20)
+
+		order := []int{}
+		for !pq.Empty() {
+			_, val := pq.Dequeue()
+			order = append(order, val)
+		}
+
+		assert.Equal(t, []int{5, 20, 35}, order)
+	})
+
+	// Happy Path
+	t.Run("Payloads keep their type", func(t *testing.T) {
+		type job struct {
+			name string
+		}
+		pq := NewPriorityQueue[int, *job](HighestFirst)
+		pq.Enqueue(1, &job{name: "deliver"})
+
+		_, j := pq.Dequeue()
+
+		assert.Equal(t, "deliver", j.name)
+	})
+
+	// Edge Case
+	t.Run("A nil payload comes back as the zero value", func(t *testing.T) {
+		pq := NewPriorityQueue[int, error](HighestFirst)
+		pq.Enqueue(1, nil)
+
+		priority, err := pq.Dequeue()
+
+		assert.Equal(t, 1, priority)
+		assert.Nil(t, err)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for NewStablePriorityQueue */
+/*--------------------------------------------------------------------------------------------------*/
+func TestStablePriorityQueue(t *testing.T) {
+
+	// Happy Path
+	t.Run("Equal priorities are dequeued in insertion order", func(t *testing.T) {
+		pq := NewStablePriorityQueue[int, int](HighestFirst)
+		for i := 0; i < 100; i++ {
+			pq.Enqueue(1, i)
+		}
+
+		for i := 0; i < 100; i++ {
+			_, val := pq.Dequeue()
+			assert.Equal(t, i, val)
+		}
+	})
+
+	// Happy Path
+	t.Run("Ties stay FIFO within each priority in both orders", func(t *testing.T) {
+		for _, order := range []PriorityOrder{HighestFirst, LowestFirst} {
+			pq := NewStablePriorityQueue[int, string](order)
+			pq.Enqueue(1, "a1")
+			pq.Enqueue(2, "b1")
+			pq.Enqueue(1, "a2")
+			pq.Enqueue(2, "b2")
+			pq.Enqueue(1, "a3")
+
+			dequeued := []string{}
+			for !pq.Empty() {
+				_, val := pq.Dequeue()
+				dequeued = append(dequeued, val)
+			}
+
+			if order == HighestFirst {
+				assert.Equal(t, []string{"b1", "b2", "a1", "a2", "a3"}, dequeued)
+			} else {
+				assert.Equal(t, []string{"a1", "a2", "a3", "b1", "b2"}, dequeued)
+			}
+		}
+	})
+
+	// Happy Path
+	t.Run("FIFO among ties holds across interleaved Enqueue and Dequeue", func(t *testing.T) {
+		type job struct {
+			priority int
+			seq      int
+		}
+		pq := NewStablePriorityQueue[int, job](LowestFirst)
+		rng := rand.New(rand.NewSource(7))
+		lastSeen := map[int]int{} // priority -> seq of the last job dequeued at that priority
+		seq := 0
+
+		dequeue := func() {
+			priority, j := pq.Dequeue()
+			assert.Equal(t, priority, j.priority)
+			if last, ok := lastSeen[priority]; ok {
+				assert.Greater(t, j.seq, last, "job %d at priority %d dequeued after a newer one", j.seq, priority)
+			}
+			lastSeen[priority] = j.seq
+		}
+
+		for round := 0; round < 5000; round++ {
+			if pq.Empty() || rng.Intn(3) != 0 {
+				priority := rng.Intn(4)
+				pq.Enqueue(priority, job{priority: priority, seq: seq})
+				seq++
+			} else {
+				dequeue()
+			}
+		}
+		for !pq.Empty() {
+			dequeue()
+		}
+	})
+
+	// Edge Case
+	t.Run("Priority still wins over insertion order", func(t *testing.T) {
+		pq := NewStablePriorityQueue[int, string](HighestFirst)
+		pq.Enqueue(1, "old")
+		pq.Enqueue(9, "new")
+
+		_, val := pq.Front()
+
+		assert.Equal(t, "new", val)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for UpdatePriority, Remove and Contains */
+/*--------------------------------------------------------------------------------------------------*/
+func TestPriorityQueue_Handles(t *testing.T) {
+
+	// Happy Path
+	t.Run("Enqueued items are contained until dequeued", func(t *testing.T) {
+		pq := NewPriorityQueue[int, string](LowestFirst)
+		a := pq.Enqueue(1, "a")
+		b := pq.Enqueue(2, "b")
+
+		assert.True(t, pq.Contains(a))
+		assert.True(t, pq.Contains(b))
+
+		pq.Dequeue()
+
+		assert.False(t, pq.Contains(a))
+		assert.True(t, pq.Contains(b))
+	})
+
+	// Happy Path
+	t.Run("UpdatePriority moves an item up and down", func(t *testing.T) {
+		pq := NewPriorityQueue[int, string](LowestFirst)
+		pq.Enqueue(5, "rider-a")
+		b := pq.Enqueue(10, "rider-b")
+		pq.Enqueue(7, "rider-c")
+
+		assert.True(t, pq.UpdatePriority(b, 1))
+		_, val := pq.Front()
+		assert.Equal(t, "rider-b", val)
+
+		assert.True(t, pq.UpdatePriority(b, 20))
+		_, val = pq.Front()
+		assert.Equal(t, "rider-a", val)
+		assert.Equal(t, 3, pq.Size())
+	})
+
+	// Happy Path
+	t.Run("Remove cancels an item mid-queue", func(t *testing.T) {
+		pq := NewPriorityQueue[int, string](HighestFirst)
+		pq.Enqueue(3, "a")
+		b := pq.Enqueue(2, "b")
+		pq.Enqueue(1, "c")
+
+		assert.True(t, pq.Remove(b))
+		assert.False(t, pq.Contains(b))
+		assert.Equal(t, 2, pq.Size())
+
+		_, first := pq.Dequeue()
+		_, second := pq.Dequeue()
+		assert.Equal(t, []string{"a", "c"}, []string{first, second})
+	})
+
+	// Edge Case
+	t.Run("Stale, zero and foreign handles are rejected", func(t *testing.T) {
+		pq := NewPriorityQueue[int, string](HighestFirst)
+		other := NewPriorityQueue[int, string](HighestFirst)
+		a := pq.Enqueue(1, "a")
+		foreign := other.Enqueue(1, "foreign")
+		pq.Remove(a)
+
+		assert.False(t, pq.Remove(a))
+		assert.False(t, pq.UpdatePriority(a, 5))
+		assert.False(t, pq.Contains(PQHandle[int, string]{}))
+		assert.False(t, pq.Contains(foreign))
+		assert.False(t, pq.Remove(foreign))
+		assert.Equal(t, 1, other.Size())
+	})
+
+	// Edge Case
+	t.Run("Stable queues keep an updated item's place among ties", func(t *testing.T) {
+		pq := NewStablePriorityQueue[int, string](LowestFirst)
+		a := pq.Enqueue(5, "a")
+		pq.Enqueue(1, "b")
+
+		pq.UpdatePriority(a, 1)
+
+		_, val := pq.Front()
+		assert.Equal(t, "a", val)
+	})
+
+	// Happy Path
+	t.Run("Random updates and removals keep heap order", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(3))
+		pq := NewPriorityQueue[int, int](LowestFirst)
+		handles := []PQHandle[int, int]{}
+		priorities := map[int]int{} // value -> current priority
+		for i := 0; i < 500; i++ {
+			priorities[i] = rng.Intn(1000)
+			handles = append(handles, pq.Enqueue(priorities[i], i))
+		}
+		for i := 0; i < 1000; i++ {
+			idx := rng.Intn(len(handles))
+			if rng.Intn(4) == 0 {
+				if pq.Remove(handles[idx]) {
+					delete(priorities, idx)
+				}
+			} else if pq.Contains(handles[idx]) {
+				priorities[idx] = rng.Intn(1000)
+				pq.UpdatePriority(handles[idx], priorities[idx])
+			}
+		}
+
+		assert.Equal(t, len(priorities), pq.Size())
+		last := -1
+		for !pq.Empty() {
+			priority, val := pq.Dequeue()
+			assert.Equal(t, priorities[val], priority)
+			assert.GreaterOrEqual(t, priority, last)
+			last = priority
+		}
+	})
+}
@@ -1,27 +1,90 @@
-package main
+package datastructures
 
 import (
 	"golang.org/x/exp/constraints"
 )
 
 type SetInterface[T constraints.Ordered] interface {
-	hash(val T) int      // helper function to assign val to an index in the underlying array
-	resize()             // helper function to double the size of the underlying array
-	Contains(val T) bool // returns whether set contains element val. O(1)
-	Insert(val T)        // inserts val in set if not present, increases size by 1. O(1)
-	Remove(val T)        // removes item from set if present, decreases size by 1. O(1)
-	Empty() bool         // returns whether set is empty. O(1)
-	Size() int           // returns number of elements in set. O(1)
-	Values() []T         // returns all values in the set.
+	hash(val T) int                            // helper function to assign val to an index in the underlying array
+	resize()                                   // helper function to double the size of the underlying array
+	Contains(val T) bool                       // returns whether set contains element val. O(1)
+	Insert(val T)                              // inserts val in set if not present, increases size by 1. O(1)
+	Remove(val T)                              // removes item from set if present, decreases size by 1. O(1)
+	Empty() bool                               // returns whether set is empty. O(1)
+	Size() int                                 // returns number of elements in set. O(1)
+	Values() []T                               // returns all values in the set.
+	Union(other *Set[T]) *Set[T]               // returns a new set of values in either set. O(n + m)
+	Intersection(other *Set[T]) *Set[T]        // returns a new set of values in both sets. O(min(n, m))
+	Difference(other *Set[T]) *Set[T]          // returns a new set of values in this set but not in other. O(n)
+	SymmetricDifference(other *Set[T]) *Set[T] // returns a new set of values in exactly one of the sets. O(n + m)
+	IsSubset(other *Set[T]) bool               // returns whether every value in this set is in other. O(n)
+	IsSuperset(other *Set[T]) bool             // returns whether every value in other is in this set. O(m)
+	Equal(other *Set[T]) bool                  // returns whether both sets hold the same values. O(n)
+	Disjoint(other *Set[T]) bool               // returns whether the sets share no values. O(min(n, m))
 }
 
-// Set should implement SetInterface; write a constructor & methods to complete it
+// Set implements SetInterface as an array of BinarySearchTree buckets that doubles once maxFill is exceeded
 type Set[T constraints.Ordered] struct {
 	arr     []BinarySearchTree[T]
 	maxFill float32 // value in (0, 1] indicating the maximum allowable ratio of elements to arr before arr is resized
 	size    int
 }
 
+// NewSet returns an empty set with initialCapacity buckets. A capacity below 1
+// falls back to 16 buckets and a maxFill outside (0, 1] falls back to 0.75.
+func NewSet[T constraints.Ordered](initialCapacity int, maxFill float32) *Set[T] {
+	if initialCapacity < 1 {
+		initialCapacity = defaultCapacity
+	}
+	if maxFill <= 0 || maxFill > 1 {
+		maxFill = defaultMaxFill
+	}
+	return &Set[T]{
+		arr:     make([]BinarySearchTree[T], initialCapacity),
+		maxFill: maxFill,
+		size:    0,
+	}
+}
+
+func (s *Set[T]) hash(val T) int {
+	return int(hashKey(val) % uint64(len(s.arr)))
+}
+
+func (s *Set[T]) resize() {
+	old := s.arr
+	s.arr = make([]BinarySearchTree[T], 2*len(old))
+	for i := range old {
+		for _, pair := range old[i].PreOrderTraversal() {
+			s.arr[s.hash(pair.Key)].Insert(pair.Key, nil)
+		}
+	}
+}
+
+func (s *Set[T]) Contains(val T) bool {
+	return s.arr[s.hash(val)].Contains(val)
+}
+
+func (s *Set[T]) Insert(val T) {
+	bucket := &s.arr[s.hash(val)]
+	if bucket.Contains(val) {
+		return
+	}
+	bucket.Insert(val, nil)
+	s.size++
+	if float32(s.size)/float32(len(s.arr)) > s.maxFill {
+		s.resize()
+	}
+}
+
+func (s *Set[T]) Remove(val T) {
+	bucket := &s.arr[s.hash(val)]
+	if !bucket.Contains(val) {
+		return
+	}
+	bucket.Remove(val)
+	s.size--
+}
+
 func (s *Set[T]) Empty() bool {
 	return s.size == 0
 }
@@ -32,3 +95,93 @@ func (s *Set[T]) Size() int {
 	}
 	return s.size
 }
+
+func (s *Set[T]) Values() []T {
+	values := make([]T, 0, s.size)
+	for i := range s.arr {
+		for _, pair := range s.arr[i].InOrderTraversal() {
+			values = append(values, pair.Key)
+		}
+	}
+	return values
+}
+
+// smallerLarger orders two sets by size so intersections iterate the smaller one.
+func smallerLarger[T constraints.Ordered](a, b *Set[T]) (*Set[T], *Set[T]) {
+	if a.size <= b.size {
+		return a, b
+	}
+	return b, a
+}
+
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := NewSet[T](len(s.arr), s.maxFill)
+	for _, val := range s.Values() {
+		result.Insert(val)
+	}
+	for _, val := range other.Values() {
+		result.Insert(val)
+	}
+	return result
+}
+
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	result := NewSet[T](len(s.arr), s.maxFill)
+	smaller, larger := smallerLarger(s, other)
+	for _, val := range smaller.Values() {
+		if larger.Contains(val) {
+			result.Insert(val)
+		}
+	}
+	return result
+}
+
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := NewSet[T](len(s.arr), s.maxFill)
+	for _, val := range s.Values() {
+		if !other.Contains(val) {
+			result.Insert(val)
+		}
+	}
+	return result
+}
+
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := s.Difference(other)
+	for _, val := range other.Values() {
+		if !s.Contains(val) {
+			result.Insert(val)
+		}
+	}
+	return result
+}
+
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.size > other.size {
+		return false
+	}
+	for _, val := range s.Values() {
+		if !other.Contains(val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.size == other.size && s.IsSubset(other)
+}
+
+func (s *Set[T]) Disjoint(other *Set[T]) bool {
+	smaller, larger := smallerLarger(s, other)
+	for _, val := range smaller.Values() {
+		if larger.Contains(val) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+package datastructures
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ SetInterface[int] = (*Set[int])(nil)
+
+func setOf[T int | string](vals ...T) *Set[T] {
+	s := NewSet[T](4, 0.75)
+	for _, val := range vals {
+		s.Insert(val)
+	}
+	return s
+}
+
+func sortedValues(s *Set[int]) []int {
+	values := s.Values()
+	sort.Ints(values)
+	return values
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for NewSet */
+/*--------------------------------------------------------------------------------------------------*/
+func TestNewSet(t *testing.T) {
+
+	// Happy Path
+	t.Run("New set is empty", func(t *testing.T) {
+		s := NewSet[int](8, 0.5)
+
+		assert.True(t, s.Empty())
+		assert.Equal(t, 0, s.Size())
+		assert.Equal(t, 8, len(s.arr))
+	})
+
+	// Edge Case
+	t.Run("Invalid capacity and max fill fall back to defaults", func(t *testing.T) {
+		s := NewSet[int](-1, 0)
+
+		assert.Equal(t, defaultCapacity, len(s.arr))
+		assert.Equal(t, float32(defaultMaxFill), s.maxFill)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for Insert, Contains and Remove */
+/*--------------------------------------------------------------------------------------------------*/
+func TestSet_InsertContainsRemove(t *testing.T) {
+
+	// Happy Path
+	t.Run("Insert adds values once", func(t *testing.T) {
+		s := NewSet[string](4, 0.75)
+
+		s.Insert("a")
+		s.Insert("b")
+		s.Insert("a")
+
+		assert.Equal(t, 2, s.Size())
+		assert.True(t, s.Contains("a"))
+		assert.True(t, s.Contains("b"))
+		assert.False(t, s.Contains("c"))
+	})
+
+	// Happy Path
+	t.Run("Remove deletes present values", func(t *testing.T) {
+		s := setOf(1, 2, 3)
+
+		s.Remove(2)
+
+		assert.Equal(t, 2, s.Size())
+		assert.False(t, s.Contains(2))
+	})
+
+	// Edge Case
+	t.Run("Remove on a missing value does nothing", func(t *testing.T) {
+		s := setOf(1)
+
+		s.Remove(5)
+
+		assert.Equal(t, 1, s.Size())
+	})
+
+	// Happy Path
+	t.Run("Values survive repeated resizes", func(t *testing.T) {
+		s := NewSet[int](1, 0.75)
+		for i := 0; i < 500; i++ {
+			s.Insert(i)
+		}
+
+		assert.Equal(t, 500, s.Size())
+		assert.LessOrEqual(t, float32(s.Size())/float32(len(s.arr)), float32(0.75))
+		for i := 0; i < 500; i++ {
+			assert.True(t, s.Contains(i))
+		}
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for set algebra */
+/*--------------------------------------------------------------------------------------------------*/
+func TestSet_Algebra(t *testing.T) {
+
+	// Happy Path
+	t.Run("Union, Intersection, Difference and SymmetricDifference", func(t *testing.T) {
+		a := setOf(1, 2, 3, 4)
+		b := setOf(3, 4, 5)
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, sortedValues(a.Union(b)))
+		assert.Equal(t, []int{3, 4}, sortedValues(a.Intersection(b)))
+		assert.Equal(t, []int{1, 2}, sortedValues(a.Difference(b)))
+		assert.Equal(t, []int{5}, sortedValues(b.Difference(a)))
+		assert.Equal(t, []int{1, 2, 5}, sortedValues(a.SymmetricDifference(b)))
+	})
+
+	// Happy Path
+	t.Run("Operations do not mutate the operands", func(t *testing.T) {
+		a := setOf(1, 2)
+		b := setOf(2, 3)
+
+		a.Union(b)
+		a.Intersection(b)
+		a.Difference(b)
+		a.SymmetricDifference(b)
+
+		assert.Equal(t, []int{1, 2}, sortedValues(a))
+		assert.Equal(t, []int{2, 3}, sortedValues(b))
+	})
+
+	// Edge Case
+	t.Run("Operations with an empty set", func(t *testing.T) {
+		a := setOf(1, 2)
+		empty := setOf[int]()
+
+		assert.True(t, a.Union(empty).Equal(a))
+		assert.True(t, a.Intersection(empty).Empty())
+		assert.True(t, a.Difference(empty).Equal(a))
+		assert.True(t, empty.Difference(a).Empty())
+		assert.True(t, a.SymmetricDifference(empty).Equal(a))
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for set comparisons */
+/*--------------------------------------------------------------------------------------------------*/
+func TestSet_Comparisons(t *testing.T) {
+
+	// Happy Path
+	t.Run("IsSubset and IsSuperset", func(t *testing.T) {
+		small := setOf(1, 2)
+		big := setOf(1, 2, 3)
+
+		assert.True(t, small.IsSubset(big))
+		assert.False(t, big.IsSubset(small))
+		assert.True(t, big.IsSuperset(small))
+		assert.False(t, small.IsSuperset(big))
+		assert.True(t, small.IsSubset(small))
+	})
+
+	// Happy Path
+	t.Run("Equal ignores insertion order and capacity", func(t *testing.T) {
+		a := setOf("x", "y", "z")
+		b := NewSet[string](64, 0.5)
+		b.Insert("z")
+		b.Insert("x")
+		b.Insert("y")
+
+		assert.True(t, a.Equal(b))
+		b.Remove("x")
+		assert.False(t, a.Equal(b))
+	})
+
+	// Happy Path
+	t.Run("Disjoint", func(t *testing.T) {
+		assert.True(t, setOf(1, 2).Disjoint(setOf(3, 4)))
+		assert.False(t, setOf(1, 2).Disjoint(setOf(2, 3)))
+	})
+
+	// Edge Case
+	t.Run("Empty set relationships", func(t *testing.T) {
+		empty := setOf[int]()
+		a := setOf(1)
+
+		assert.True(t, empty.IsSubset(a))
+		assert.True(t, empty.Disjoint(a))
+		assert.True(t, empty.Equal(setOf[int]()))
+	})
+}
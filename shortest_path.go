@@ -0,0 +1,121 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	ErrNodeNotFound   = errors.New("graph: node not found")
+	ErrNegativeWeight = errors.New("graph: negative edge weight")
+	ErrNoPath         = errors.New("graph: no path between nodes")
+)
+
+// ShortestPaths is a single-source shortest path tree. Dist holds the total
+// cost from Source to every reachable node and Prev holds the node before it
+// on its shortest path; Source itself has no entry in Prev.
+type ShortestPaths[T constraints.Ordered] struct {
+	Source T
+	Dist   map[T]float64
+	Prev   map[T]T
+}
+
+// PathTo returns the nodes on the shortest path from Source to dst, both
+// included, and whether dst is reachable.
+func (sp *ShortestPaths[T]) PathTo(dst T) ([]T, bool) {
+	if _, ok := sp.Dist[dst]; !ok {
+		return nil, false
+	}
+	path := []T{dst}
+	for node := dst; node != sp.Source; {
+		node = sp.Prev[node]
+		path = append(path, node)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, true
+}
+
+// ShortestPath runs Dijkstra's algorithm from src and returns the cheapest
+// path to dst along with its total weight. O((n + e)logn)
+func (g *Graph[T]) ShortestPath(src T, dst T) ([]T, float64, error) {
+	return g.view().shortestPath(src, dst)
+}
+
+// ShortestPathsFrom runs Dijkstra's algorithm from src and returns the
+// distance and predecessor of every node reachable from it. O((n + e)logn)
+func (g *Graph[T]) ShortestPathsFrom(src T) (*ShortestPaths[T], error) {
+	return g.view().dijkstra(src)
+}
+
+// ShortestPath runs Dijkstra's algorithm from src along outgoing edges and
+// returns the cheapest path to dst along with its total weight. O((n + e)logn)
+func (g *DirectedGraph[T]) ShortestPath(src T, dst T) ([]T, float64, error) {
+	return g.view().shortestPath(src, dst)
+}
+
+// ShortestPathsFrom runs Dijkstra's algorithm from src along outgoing edges and
+// returns the distance and predecessor of every node reachable from it. O((n + e)logn)
+func (g *DirectedGraph[T]) ShortestPathsFrom(src T) (*ShortestPaths[T], error) {
+	return g.view().dijkstra(src)
+}
+
+func (v graphView[T]) shortestPath(src T, dst T) ([]T, float64, error) {
+	if _, ok := v.nodes[dst]; !ok {
+		return nil, 0, fmt.Errorf("%w: %v", ErrNodeNotFound, dst)
+	}
+	sp, err := v.dijkstra(src)
+	if err != nil {
+		return nil, 0, err
+	}
+	path, ok := sp.PathTo(dst)
+	if !ok {
+		return nil, 0, fmt.Errorf("%w: %v to %v", ErrNoPath, src, dst)
+	}
+	return path, sp.Dist[dst], nil
+}
+
+func (v graphView[T]) dijkstra(src T) (*ShortestPaths[T], error) {
+	if _, ok := v.nodes[src]; !ok {
+		return nil, fmt.Errorf("%w: %v", ErrNodeNotFound, src)
+	}
+	sp := &ShortestPaths[T]{
+		Source: src,
+		Dist:   map[T]float64{src: 0},
+		Prev:   make(map[T]T),
+	}
+	done := make(map[T]struct{})
+	queued := make(map[T]PQHandle[float64, T])
+	pq := NewStablePriorityQueue[float64, T](LowestFirst)
+	queued[src] = pq.Enqueue(0, src)
+
+	for !pq.Empty() {
+		dist, node := pq.Dequeue()
+		done[node] = struct{}{}
+
+		for _, neighbor := range v.neighborList(node) {
+			weight := v.weights[node][neighbor]
+			if weight < 0 {
+				return nil, fmt.Errorf("%w: %v to %v is %v", ErrNegativeWeight, node, neighbor, weight)
+			}
+			if _, ok := done[neighbor]; ok {
+				continue
+			}
+			candidate := dist + weight
+			if best, ok := sp.Dist[neighbor]; ok && best <= candidate {
+				continue
+			}
+			sp.Dist[neighbor] = candidate
+			sp.Prev[neighbor] = node
+			if handle, ok := queued[neighbor]; ok {
+				pq.UpdatePriority(handle, candidate)
+			} else {
+				queued[neighbor] = pq.Enqueue(candidate, neighbor)
+			}
+		}
+	}
+	return sp, nil
+}
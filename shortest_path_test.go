@@ -0,0 +1,164 @@
+package datastructures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cityGraph is a small road map where the direct NYC-DC edge is slower than going through Philly.
+func cityGraph() *Graph[string] {
+	g := NewGraph[string]()
+	g.AddEdge("NYC", "Philly", 95)
+	g.AddEdge("Philly", "DC", 140)
+	g.AddEdge("NYC", "DC", 260)
+	g.AddEdge("NYC", "Boston", 215)
+	g.AddEdge("Boston", "Portland", 110)
+	g.Insert(Pair[string]{Key: "Bermuda"}, nil)
+	return g
+}
+
+// tieGraph has five equal-cost paths from "a" to "z", one through each of b, c, e, f and g.
+func tieGraph() *Graph[string] {
+	g := NewOrderedGraph[string](KeyOrder)
+	for _, middle := range []string{"g", "e", "c", "f", "b"} {
+		g.AddEdge("a", middle, 1)
+		g.AddEdge(middle, "z", 1)
+	}
+	return g
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for ShortestPath */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_ShortestPath(t *testing.T) {
+
+	// Happy Path
+	t.Run("Cheaper multi-hop path beats a direct edge", func(t *testing.T) {
+		path, cost, err := cityGraph().ShortestPath("NYC", "DC")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"NYC", "Philly", "DC"}, path)
+		assert.Equal(t, 235.0, cost)
+	})
+
+	// Happy Path
+	t.Run("Undirected edges work in both directions", func(t *testing.T) {
+		path, cost, err := cityGraph().ShortestPath("Portland", "Philly")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Portland", "Boston", "NYC", "Philly"}, path)
+		assert.Equal(t, 420.0, cost)
+	})
+
+	// Edge Case
+	t.Run("Path from a node to itself", func(t *testing.T) {
+		path, cost, err := cityGraph().ShortestPath("NYC", "NYC")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"NYC"}, path)
+		assert.Equal(t, 0.0, cost)
+	})
+
+	// Edge Case
+	t.Run("Equal-cost ties follow NodeOrder on every run", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			path, cost, err := tieGraph().ShortestPath("a", "z")
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"a", "b", "z"}, path)
+			assert.Equal(t, 2.0, cost)
+		}
+	})
+
+	// Edge Case
+	t.Run("Unreachable destination", func(t *testing.T) {
+		_, _, err := cityGraph().ShortestPath("NYC", "Bermuda")
+
+		assert.ErrorIs(t, err, ErrNoPath)
+	})
+
+	// Edge Case
+	t.Run("Unknown nodes", func(t *testing.T) {
+		_, _, err := cityGraph().ShortestPath("Atlantis", "NYC")
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+
+		_, _, err = cityGraph().ShortestPath("NYC", "Atlantis")
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+
+	// Edge Case
+	t.Run("Negative weights are rejected", func(t *testing.T) {
+		g := cityGraph()
+		g.SetWeight("Philly", "DC", -5)
+
+		_, _, err := g.ShortestPath("NYC", "DC")
+
+		assert.ErrorIs(t, err, ErrNegativeWeight)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for ShortestPathsFrom */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_ShortestPathsFrom(t *testing.T) {
+
+	// Happy Path
+	t.Run("Distances and predecessors for every reachable node", func(t *testing.T) {
+		sp, err := cityGraph().ShortestPathsFrom("NYC")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float64{
+			"NYC": 0, "Philly": 95, "DC": 235, "Boston": 215, "Portland": 325,
+		}, sp.Dist)
+		assert.Equal(t, map[string]string{
+			"Philly": "NYC", "DC": "Philly", "Boston": "NYC", "Portland": "Boston",
+		}, sp.Prev)
+
+		path, ok := sp.PathTo("Portland")
+		assert.True(t, ok)
+		assert.Equal(t, []string{"NYC", "Boston", "Portland"}, path)
+
+		_, ok = sp.PathTo("Bermuda")
+		assert.False(t, ok)
+	})
+
+	// Happy Path
+	t.Run("Unweighted Insert edges count hops", func(t *testing.T) {
+		g := NewGraph[int]()
+		g.Insert(Pair[int]{Key: 1}, []int{2, 3})
+		g.Insert(Pair[int]{Key: 2}, []int{4})
+		g.Insert(Pair[int]{Key: 4}, []int{5})
+
+		sp, err := g.ShortestPathsFrom(1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]float64{1: 0, 2: 1, 3: 1, 4: 2, 5: 3}, sp.Dist)
+	})
+
+	// Happy Path
+	t.Run("Directed graphs only follow outgoing edges", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", 1)
+		g.AddEdge("c", "a", 1)
+		g.AddEdge("a", "c", 5)
+
+		path, cost, err := g.ShortestPath("a", "c")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, path)
+		assert.Equal(t, 2.0, cost)
+
+		path, cost, err = g.ShortestPath("c", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"c", "a", "b"}, path)
+		assert.Equal(t, 2.0, cost)
+	})
+
+	// Edge Case
+	t.Run("Unknown source", func(t *testing.T) {
+		_, err := NewDirectedGraph[string]().ShortestPathsFrom("a")
+
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+}
@@ -1,4 +1,4 @@
-package main
+package datastructures
 
 type SingleLinkNode[T any] struct {
 	Data T
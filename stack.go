@@ -1,4 +1,4 @@
-package main
+package datastructures
 
 // LIFO
 type StackInterface[T any] interface {
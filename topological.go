@@ -0,0 +1,192 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+var ErrCycle = errors.New("graph: cycle")
+
+// CycleError reports a cycle that rules out a topological order. Cycle lists
+// its nodes in edge order, starting from the smallest key; the last node has
+// an edge back to the first.
+type CycleError[T constraints.Ordered] struct {
+	Cycle []T
+}
+
+func (e *CycleError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrCycle, e.Cycle)
+}
+
+func (e *CycleError[T]) Unwrap() error {
+	return ErrCycle
+}
+
+// TopologicalSort returns every node ordered so that each edge points forward,
+// using Kahn's algorithm: the nodes of TopologicalLevels, level by level. If
+// the graph has a cycle it returns a *CycleError holding one. O(n + e)
+func (g *DirectedGraph[T]) TopologicalSort() ([]T, error) {
+	levels, err := g.view().kahnLevels()
+	if err != nil {
+		return nil, err
+	}
+	order := make([]T, 0, len(g.nodes))
+	for _, level := range levels {
+		order = append(order, level...)
+	}
+	return order, nil
+}
+
+// TopologicalLevels groups the nodes for parallel scheduling. Level 0 holds
+// the nodes with no incoming edges, and every later level holds the nodes whose
+// in-neighbors all sit in earlier levels, so no two nodes of a level depend on
+// each other. If the graph has a cycle it returns a *CycleError. O(n + e)
+func (g *DirectedGraph[T]) TopologicalLevels() ([][]T, error) {
+	return g.view().kahnLevels()
+}
+
+// TopologicalSortDFS returns an order with the same guarantee as
+// TopologicalSort, built from reverse DFS finish times instead. O(n + e)
+func (g *DirectedGraph[T]) TopologicalSortDFS() ([]T, error) {
+	return g.view().dfsTopological()
+}
+
+// HasCycle reports whether some path along outgoing edges leads back to where
+// it started. O(n + e)
+func (g *DirectedGraph[T]) HasCycle() bool {
+	_, err := g.view().dfsTopological()
+	return err != nil
+}
+
+// HasCycle reports whether the graph contains a cycle. A self-loop counts as
+// one; the single edge joining two neighbors does not. O(n + e)
+func (g *Graph[T]) HasCycle() bool {
+	return g.view().hasUndirectedCycle()
+}
+
+func (v graphView[T]) kahnLevels() ([][]T, error) {
+	inDegree := make(map[T]int, len(v.nodes))
+	for _, neighbors := range v.adj {
+		for to := range neighbors {
+			inDegree[to]++
+		}
+	}
+	level := []T{}
+	for _, node := range v.nodeList() {
+		if inDegree[node] == 0 {
+			level = append(level, node)
+		}
+	}
+
+	levels := [][]T{}
+	placed := 0
+	for len(level) > 0 {
+		levels = append(levels, level)
+		placed += len(level)
+		next := []T{}
+		for _, node := range level {
+			for _, neighbor := range v.neighborList(node) {
+				inDegree[neighbor]--
+				if inDegree[neighbor] == 0 {
+					next = append(next, neighbor)
+				}
+			}
+		}
+		level = v.sortNodes(next)
+	}
+
+	if placed < len(v.nodes) {
+		// Kahn's algorithm only shows that a cycle exists; the DFS finds one.
+		_, err := v.dfsTopological()
+		return nil, err
+	}
+	return levels, nil
+}
+
+// dfsTopological orders nodes by reverse DFS finish time. Reaching a node that
+// is still on the DFS path closes a cycle.
+func (v graphView[T]) dfsTopological() ([]T, error) {
+	const (
+		unvisited = iota
+		onPath
+		finished
+	)
+	state := make(map[T]int, len(v.nodes))
+	parent := make(map[T]T)
+	order := make([]T, 0, len(v.nodes))
+	stack := NewStack[*dfsFrame[T]]()
+
+	for _, root := range v.nodeList() {
+		if state[root] != unvisited {
+			continue
+		}
+		state[root] = onPath
+		stack.Push(&dfsFrame[T]{visit: Visit[T]{Node: root}, neighbors: v.neighborList(root)})
+
+		for !stack.Empty() {
+			frame := stack.Top()
+			node := frame.visit.Node
+			if frame.next == len(frame.neighbors) {
+				stack.Pop()
+				state[node] = finished
+				order = append(order, node)
+				continue
+			}
+			neighbor := frame.neighbors[frame.next]
+			frame.next++
+
+			switch state[neighbor] {
+			case onPath:
+				// neighbor is an ancestor of node; the path between them plus node -> neighbor is the cycle
+				cycle := []T{node}
+				for curr := node; curr != neighbor; {
+					curr = parent[curr]
+					cycle = append(cycle, curr)
+				}
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return nil, &CycleError[T]{Cycle: startAtSmallest(cycle)}
+			case unvisited:
+				state[neighbor] = onPath
+				parent[neighbor] = node
+				child := Visit[T]{Node: neighbor, Parent: node, Depth: frame.visit.Depth + 1}
+				stack.Push(&dfsFrame[T]{visit: child, neighbors: v.neighborList(neighbor)})
+			}
+		}
+	}
+
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return order, nil
+}
+
+// hasUndirectedCycle relies on a connected component with c nodes being a
+// tree, and so acyclic, exactly when it has c-1 edges.
+func (v graphView[T]) hasUndirectedCycle() bool {
+	seen := make(map[T]struct{}, len(v.nodes))
+	for _, root := range v.nodeList() {
+		if _, ok := seen[root]; ok {
+			continue
+		}
+		component, _ := v.bfsFrom(root, nil)
+		ends := 0 // each edge has two ends in adj; a self-loop's are both at one node
+		for _, visit := range component {
+			seen[visit.Node] = struct{}{}
+			for neighbor := range v.adj[visit.Node] {
+				if neighbor == visit.Node {
+					ends += 2
+				} else {
+					ends++
+				}
+			}
+		}
+		if ends/2 >= len(component) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package datastructures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// jobGraph is a small build pipeline; an edge a -> b means b depends on a.
+func jobGraph() *DirectedGraph[string] {
+	g := NewOrderedDirectedGraph[string](KeyOrder)
+	g.Insert(Pair[string]{Key: "checkout"}, []string{"build"})
+	g.Insert(Pair[string]{Key: "fetch-deps"}, []string{"build"})
+	g.Insert(Pair[string]{Key: "build"}, []string{"test", "lint"})
+	g.Insert(Pair[string]{Key: "test"}, []string{"deploy"})
+	g.Insert(Pair[string]{Key: "lint"}, []string{"deploy"})
+	return g
+}
+
+func assertTopological(t *testing.T, g *DirectedGraph[string], order []string) {
+	t.Helper()
+	assert.Len(t, order, g.Size())
+	position := map[string]int{}
+	for i, node := range order {
+		position[node] = i
+	}
+	for _, from := range order {
+		for _, to := range g.OutNeighbors(from) {
+			assert.Less(t, position[from], position[to], "%s -> %s points backward", from, to)
+		}
+	}
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for TopologicalSort, TopologicalLevels and TopologicalSortDFS */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDirectedGraph_TopologicalSort(t *testing.T) {
+
+	// Happy Path
+	t.Run("Both variants order every edge forward", func(t *testing.T) {
+		g := jobGraph()
+
+		kahn, err := g.TopologicalSort()
+		assert.NoError(t, err)
+		assertTopological(t, g, kahn)
+
+		dfs, err := g.TopologicalSortDFS()
+		assert.NoError(t, err)
+		assertTopological(t, g, dfs)
+	})
+
+	// Happy Path
+	t.Run("Levels group jobs that can run in parallel", func(t *testing.T) {
+		levels, err := jobGraph().TopologicalLevels()
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"checkout", "fetch-deps"},
+			{"build"},
+			{"lint", "test"},
+			{"deploy"},
+		}, levels)
+	})
+
+	// Happy Path
+	t.Run("Disconnected nodes are all placed", func(t *testing.T) {
+		g := jobGraph()
+		g.Insert(Pair[string]{Key: "docs"}, nil)
+
+		levels, _ := g.TopologicalLevels()
+		order, _ := g.TopologicalSortDFS()
+
+		assert.Equal(t, []string{"checkout", "docs", "fetch-deps"}, levels[0])
+		assert.Contains(t, order, "docs")
+	})
+
+	// Edge Case
+	t.Run("A cycle is reported by both variants", func(t *testing.T) {
+		g := NewDirectedGraph[string]()
+		g.AddEdge("b", "c", 1)
+		g.AddEdge("c", "a", 1)
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("start", "a", 1)
+
+		_, kahnErr := g.TopologicalSort()
+		_, levelsErr := g.TopologicalLevels()
+		_, dfsErr := g.TopologicalSortDFS()
+
+		for _, err := range []error{kahnErr, levelsErr, dfsErr} {
+			var cycleErr *CycleError[string]
+			assert.True(t, errors.As(err, &cycleErr))
+			assert.ErrorIs(t, err, ErrCycle)
+			assert.Equal(t, []string{"a", "b", "c"}, cycleErr.Cycle)
+		}
+	})
+
+	// Edge Case
+	t.Run("A self-loop is a cycle of one", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(2, 2, 1)
+
+		_, err := g.TopologicalSort()
+
+		var cycleErr *CycleError[int]
+		assert.True(t, errors.As(err, &cycleErr))
+		assert.Equal(t, []int{2}, cycleErr.Cycle)
+	})
+
+	// Edge Case
+	t.Run("An empty graph has an empty order", func(t *testing.T) {
+		order, err := NewDirectedGraph[int]().TopologicalSort()
+
+		assert.NoError(t, err)
+		assert.Empty(t, order)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for HasCycle */
+/*--------------------------------------------------------------------------------------------------*/
+func TestHasCycle(t *testing.T) {
+
+	// Happy Path
+	t.Run("Directed graphs", func(t *testing.T) {
+		g := jobGraph()
+		assert.False(t, g.HasCycle())
+
+		g.AddEdge("deploy", "checkout", 1)
+		assert.True(t, g.HasCycle())
+	})
+
+	// Happy Path
+	t.Run("Undirected graphs", func(t *testing.T) {
+		g := NewGraph[int]()
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(2, 3, 1)
+		g.AddEdge(4, 5, 1)
+		assert.False(t, g.HasCycle())
+
+		g.AddEdge(3, 1, 1)
+		assert.True(t, g.HasCycle())
+		assert.True(t, friendsGraph().HasCycle())
+	})
+
+	// Edge Case
+	t.Run("A single undirected edge is not a cycle but a self-loop is", func(t *testing.T) {
+		g := NewGraph[int]()
+		g.AddEdge(1, 2, 1)
+		assert.False(t, g.HasCycle())
+
+		g.AddEdge(3, 3, 1)
+		assert.True(t, g.HasCycle())
+	})
+
+	// Edge Case
+	t.Run("Empty graphs have no cycle", func(t *testing.T) {
+		assert.False(t, NewGraph[int]().HasCycle())
+		assert.False(t, NewDirectedGraph[int]().HasCycle())
+	})
+}
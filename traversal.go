@@ -0,0 +1,197 @@
+package datastructures
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Visit describes a node reached by DFSFrom or BFSFrom. Depth is the number
+// of tree edges from the start node (the BFS level, for BFSFrom), and Parent
+// is the node it was reached from; the start node has Depth 0 and no Parent.
+type Visit[T constraints.Ordered] struct {
+	Node   T
+	Parent T
+	Depth  int
+}
+
+// Visitor is called once per visited node; returning false stops the walk.
+type Visitor[T constraints.Ordered] func(visit Visit[T]) bool
+
+// DFSFrom walks the nodes reachable from start in depth-first pre-order,
+// calling visit on each, and returns the visits made. A nil visit walks the
+// whole component. The visit that returned false is the last one returned.
+func (g *Graph[T]) DFSFrom(start T, visit Visitor[T]) ([]Visit[T], error) {
+	return g.view().dfsFrom(start, visit)
+}
+
+// BFSFrom walks the nodes reachable from start level by level, calling visit
+// on each, and returns the visits made. A nil visit walks the whole component.
+// The visit that returned false is the last one returned.
+func (g *Graph[T]) BFSFrom(start T, visit Visitor[T]) ([]Visit[T], error) {
+	return g.view().bfsFrom(start, visit)
+}
+
+// DFSFrom walks the nodes reachable from start along outgoing edges in
+// depth-first pre-order, calling visit on each, and returns the visits made.
+// A nil visit walks everything reachable. The visit that returned false is the
+// last one returned.
+func (g *DirectedGraph[T]) DFSFrom(start T, visit Visitor[T]) ([]Visit[T], error) {
+	return g.view().dfsFrom(start, visit)
+}
+
+// BFSFrom walks the nodes reachable from start along outgoing edges level by
+// level, calling visit on each, and returns the visits made. A nil visit walks
+// everything reachable. The visit that returned false is the last one returned.
+func (g *DirectedGraph[T]) BFSFrom(start T, visit Visitor[T]) ([]Visit[T], error) {
+	return g.view().bfsFrom(start, visit)
+}
+
+// DFSOrders records a depth-first traversal of the whole graph. Each node is
+// discovered when the walk first reaches it and finished once everything
+// reachable from it has been walked; both times come from one clock that ticks
+// at every discovery and finish, so they run from 1 to 2n.
+type DFSOrders[T constraints.Ordered] struct {
+	PreOrder  []T       // nodes by discovery time; the order of DepthFirstTraversal
+	PostOrder []T       // nodes by finish time
+	Discovery map[T]int // when each node was discovered
+	Finish    map[T]int // when each node was finished
+}
+
+// DepthFirstOrders walks every node depth-first, starting a new tree at each
+// node not yet reached, and returns the pre-order, post-order and times. O(n + e)
+func (g *Graph[T]) DepthFirstOrders() *DFSOrders[T] {
+	return g.view().depthFirstOrders()
+}
+
+// DepthFirstOrders walks every node depth-first along outgoing edges, starting
+// a new tree at each node not yet reached, and returns the pre-order,
+// post-order and times. O(n + e)
+func (g *DirectedGraph[T]) DepthFirstOrders() *DFSOrders[T] {
+	return g.view().depthFirstOrders()
+}
+
+// dfsFrame is a node on the DFS stack along with the neighbors it has left to try.
+// Depth-first walks push frames on a Stack instead of recursing, so deep graphs
+// can't overflow the call stack. A node is finished only once its last neighbor
+// has been tried, as on return from a recursive call.
+type dfsFrame[T constraints.Ordered] struct {
+	visit     Visit[T]
+	neighbors []T
+	next      int
+}
+
+func (v graphView[T]) dfsFrom(start T, visit Visitor[T]) ([]Visit[T], error) {
+	if _, ok := v.nodes[start]; !ok {
+		return nil, fmt.Errorf("%w: %v", ErrNodeNotFound, start)
+	}
+	visited := make(map[T]struct{})
+	result := []Visit[T]{}
+
+	// enter records a visit and reports whether the walk should go on.
+	enter := func(curr Visit[T]) bool {
+		visited[curr.Node] = struct{}{}
+		result = append(result, curr)
+		return visit == nil || visit(curr)
+	}
+
+	stack := NewStack[*dfsFrame[T]]()
+	root := Visit[T]{Node: start}
+	if !enter(root) {
+		return result, nil
+	}
+	stack.Push(&dfsFrame[T]{visit: root, neighbors: v.neighborList(start)})
+
+	for !stack.Empty() {
+		frame := stack.Top()
+		if frame.next == len(frame.neighbors) {
+			stack.Pop()
+			continue
+		}
+		neighbor := frame.neighbors[frame.next]
+		frame.next++
+		if _, ok := visited[neighbor]; ok {
+			continue
+		}
+		child := Visit[T]{Node: neighbor, Parent: frame.visit.Node, Depth: frame.visit.Depth + 1}
+		if !enter(child) {
+			return result, nil
+		}
+		stack.Push(&dfsFrame[T]{visit: child, neighbors: v.neighborList(neighbor)})
+	}
+	return result, nil
+}
+
+func (v graphView[T]) bfsFrom(start T, visit Visitor[T]) ([]Visit[T], error) {
+	if _, ok := v.nodes[start]; !ok {
+		return nil, fmt.Errorf("%w: %v", ErrNodeNotFound, start)
+	}
+	// Nodes are marked when enqueued rather than when dequeued, so each is queued once.
+	seen := map[T]struct{}{start: {}}
+	result := []Visit[T]{}
+	queue := NewQueue[Visit[T]]()
+	queue.Enqueue(Visit[T]{Node: start})
+
+	for queue.Size() > 0 {
+		curr := queue.Front()
+		queue.Dequeue()
+
+		result = append(result, curr)
+		if visit != nil && !visit(curr) {
+			return result, nil
+		}
+
+		for _, neighbor := range v.neighborList(curr.Node) {
+			if _, ok := seen[neighbor]; ok {
+				continue
+			}
+			seen[neighbor] = struct{}{}
+			queue.Enqueue(Visit[T]{Node: neighbor, Parent: curr.Node, Depth: curr.Depth + 1})
+		}
+	}
+	return result, nil
+}
+
+func (v graphView[T]) depthFirstOrders() *DFSOrders[T] {
+	orders := &DFSOrders[T]{
+		PreOrder:  make([]T, 0, len(v.nodes)),
+		PostOrder: make([]T, 0, len(v.nodes)),
+		Discovery: make(map[T]int, len(v.nodes)),
+		Finish:    make(map[T]int, len(v.nodes)),
+	}
+	clock := 0
+	discover := func(node T) {
+		clock++
+		orders.Discovery[node] = clock
+		orders.PreOrder = append(orders.PreOrder, node)
+	}
+
+	stack := NewStack[*dfsFrame[T]]()
+	for _, root := range v.nodeList() {
+		if _, ok := orders.Discovery[root]; ok {
+			continue
+		}
+		discover(root)
+		stack.Push(&dfsFrame[T]{visit: Visit[T]{Node: root}, neighbors: v.neighborList(root)})
+
+		for !stack.Empty() {
+			frame := stack.Top()
+			if frame.next == len(frame.neighbors) {
+				stack.Pop()
+				clock++
+				orders.Finish[frame.visit.Node] = clock
+				orders.PostOrder = append(orders.PostOrder, frame.visit.Node)
+				continue
+			}
+			neighbor := frame.neighbors[frame.next]
+			frame.next++
+			if _, ok := orders.Discovery[neighbor]; ok {
+				continue
+			}
+			discover(neighbor)
+			child := Visit[T]{Node: neighbor, Parent: frame.visit.Node, Depth: frame.visit.Depth + 1}
+			stack.Push(&dfsFrame[T]{visit: child, neighbors: v.neighborList(neighbor)})
+		}
+	}
+	return orders
+}
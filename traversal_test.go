@@ -0,0 +1,329 @@
+package datastructures
+
+import (
+	"math/rand"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// friendsGraph is the example graph from cmd/demo plus a separate component.
+func friendsGraph() *Graph[string] {
+	return orderedFriendsGraph(MapOrder)
+}
+
+func orderedFriendsGraph(order NodeOrder) *Graph[string] {
+	g := NewOrderedGraph[string](order)
+	g.Insert(Pair[string]{Key: "Alice"}, []string{"Bob", "Candy", "Derek", "Elaine"})
+	g.Insert(Pair[string]{Key: "Bob"}, []string{"Fred", "Alice"})
+	g.Insert(Pair[string]{Key: "Fred"}, []string{"Bob", "Helen"})
+	g.Insert(Pair[string]{Key: "Helen"}, []string{"Fred", "Candy"})
+	g.Insert(Pair[string]{Key: "Candy"}, []string{"Alice", "Helen"})
+	g.Insert(Pair[string]{Key: "Derek"}, []string{"Alice", "Elaine", "Gina"})
+	g.Insert(Pair[string]{Key: "Gina"}, []string{"Derek", "Irena"})
+	g.Insert(Pair[string]{Key: "Irena"}, []string{"Gina"})
+	g.Insert(Pair[string]{Key: "Elaine"}, []string{"Alice", "Derek"})
+	g.Insert(Pair[string]{Key: "Zed"}, []string{"Yara"})
+	return g
+}
+
+func visitedNodes[T int | string](visits []Visit[T]) []T {
+	nodes := []T{}
+	for _, visit := range visits {
+		nodes = append(nodes, visit.Node)
+	}
+	return nodes
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for DFSFrom */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_DFSFrom(t *testing.T) {
+
+	// Happy Path
+	t.Run("Visits only the start node's component", func(t *testing.T) {
+		visits, err := friendsGraph().DFSFrom("Alice", nil)
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t,
+			[]string{"Alice", "Bob", "Candy", "Derek", "Elaine", "Fred", "Gina", "Helen", "Irena"},
+			visitedNodes(visits))
+		assert.Equal(t, Visit[string]{Node: "Alice"}, visits[0])
+	})
+
+	// Happy Path
+	t.Run("Parents are earlier visits and depths follow them", func(t *testing.T) {
+		visits, _ := friendsGraph().DFSFrom("Alice", nil)
+
+		depth := map[string]int{}
+		for i, visit := range visits {
+			if i > 0 {
+				parentDepth, ok := depth[visit.Parent]
+				assert.True(t, ok, "%s visited before its parent %s", visit.Node, visit.Parent)
+				assert.Equal(t, parentDepth+1, visit.Depth)
+			}
+			depth[visit.Node] = visit.Depth
+		}
+	})
+
+	// Happy Path
+	t.Run("A path graph reports increasing depth", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+		for i := 0; i < 5; i++ {
+			g.AddEdge(i, i+1, 1)
+		}
+
+		visits, _ := g.DFSFrom(0, nil)
+
+		for i, visit := range visits {
+			assert.Equal(t, Visit[int]{Node: i, Parent: max(i-1, 0), Depth: i}, visit)
+		}
+	})
+
+	// Happy Path
+	t.Run("Returning false stops the walk", func(t *testing.T) {
+		calls := 0
+		visits, err := friendsGraph().DFSFrom("Alice", func(visit Visit[string]) bool {
+			calls++
+			return calls < 3
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		assert.Len(t, visits, 3)
+	})
+
+	// Edge Case
+	t.Run("Stopping on the start node", func(t *testing.T) {
+		visits, _ := friendsGraph().DFSFrom("Alice", func(Visit[string]) bool { return false })
+
+		assert.Equal(t, []string{"Alice"}, visitedNodes(visits))
+	})
+
+	// Edge Case
+	t.Run("Unknown start node", func(t *testing.T) {
+		_, err := friendsGraph().DFSFrom("Nobody", nil)
+
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for BFSFrom */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_BFSFrom(t *testing.T) {
+
+	// Happy Path
+	t.Run("Levels are hop counts from the start", func(t *testing.T) {
+		visits, err := friendsGraph().BFSFrom("Alice", nil)
+
+		assert.NoError(t, err)
+		levels := map[string]int{}
+		parents := map[string]string{}
+		for _, visit := range visits {
+			levels[visit.Node] = visit.Depth
+			parents[visit.Node] = visit.Parent
+		}
+		assert.Equal(t, map[string]int{
+			"Alice": 0,
+			"Bob":   1, "Candy": 1, "Derek": 1, "Elaine": 1,
+			"Fred": 2, "Helen": 2, "Gina": 2,
+			"Irena": 3,
+		}, levels)
+		assert.Equal(t, "Derek", parents["Gina"])
+		assert.Equal(t, "Gina", parents["Irena"])
+	})
+
+	// Happy Path
+	t.Run("Levels never decrease", func(t *testing.T) {
+		visits, _ := friendsGraph().BFSFrom("Irena", nil)
+
+		for i := 1; i < len(visits); i++ {
+			assert.GreaterOrEqual(t, visits[i].Depth, visits[i-1].Depth)
+		}
+	})
+
+	// Happy Path
+	t.Run("Stop once a target is found", func(t *testing.T) {
+		visits, _ := friendsGraph().BFSFrom("Alice", func(visit Visit[string]) bool {
+			return visit.Node != "Gina"
+		})
+
+		last := visits[len(visits)-1]
+		assert.Equal(t, "Gina", last.Node)
+		assert.Equal(t, 2, last.Depth)
+		assert.NotContains(t, visitedNodes(visits), "Irena")
+	})
+
+	// Happy Path
+	t.Run("Directed graphs follow outgoing edges only", func(t *testing.T) {
+		g := NewDirectedGraph[int]()
+		g.AddEdge(1, 2, 1)
+		g.AddEdge(3, 1, 1)
+
+		visits, _ := g.BFSFrom(1, nil)
+
+		assert.Equal(t, []int{1, 2}, visitedNodes(visits))
+	})
+
+	// Edge Case
+	t.Run("Unknown start node", func(t *testing.T) {
+		_, err := NewDirectedGraph[int]().BFSFrom(1, nil)
+
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for NodeOrder */
+/*--------------------------------------------------------------------------------------------------*/
+func TestGraph_NodeOrder(t *testing.T) {
+
+	// Happy Path
+	t.Run("KeyOrder traverses in sorted key order", func(t *testing.T) {
+		g := orderedFriendsGraph(KeyOrder)
+
+		assert.Equal(t,
+			[]string{"Alice", "Bob", "Fred", "Helen", "Candy", "Derek", "Elaine", "Gina", "Irena", "Yara", "Zed"},
+			g.DepthFirstTraversal())
+		assert.Equal(t,
+			[]string{"Alice", "Bob", "Candy", "Derek", "Elaine", "Fred", "Helen", "Gina", "Irena", "Yara", "Zed"},
+			g.BreadthFirstTraversal())
+		assert.Equal(t, []string{"Alice", "Elaine", "Gina"}, g.Neighbors("Derek"))
+	})
+
+	// Happy Path
+	t.Run("InsertionOrder traverses in the order nodes were added", func(t *testing.T) {
+		g := orderedFriendsGraph(InsertionOrder)
+
+		assert.Equal(t,
+			[]string{"Alice", "Bob", "Fred", "Helen", "Candy", "Derek", "Elaine", "Gina", "Irena", "Zed", "Yara"},
+			g.DepthFirstTraversal())
+		assert.Equal(t,
+			[]string{"Alice", "Bob", "Candy", "Derek", "Elaine", "Fred", "Helen", "Gina", "Irena", "Zed", "Yara"},
+			g.BreadthFirstTraversal())
+		assert.Equal(t, []string{"Candy", "Fred"}, g.Neighbors("Helen"))
+	})
+
+	// Happy Path
+	t.Run("Ordered traversals repeat exactly", func(t *testing.T) {
+		for _, order := range []NodeOrder{KeyOrder, InsertionOrder} {
+			want, _ := orderedFriendsGraph(order).DFSFrom("Alice", nil)
+			for i := 0; i < 20; i++ {
+				got, _ := orderedFriendsGraph(order).DFSFrom("Alice", nil)
+				assert.Equal(t, want, got)
+			}
+		}
+	})
+
+	// Edge Case
+	t.Run("A removed node rejoins at the end of insertion order", func(t *testing.T) {
+		g := NewOrderedGraph[int](InsertionOrder)
+		g.AddEdge(3, 1, 1)
+		g.AddEdge(3, 2, 1)
+
+		g.Remove(1)
+		g.AddEdge(3, 1, 1)
+
+		assert.Equal(t, []int{3, 2, 1}, g.Nodes())
+		assert.Equal(t, []int{2, 1}, g.Neighbors(3))
+	})
+}
+
+/*--------------------------------------------------------------------------------------------------*/
+/* Test for DepthFirstOrders */
+/*--------------------------------------------------------------------------------------------------*/
+func TestDepthFirstOrders(t *testing.T) {
+
+	// Happy Path
+	t.Run("Pre-order, post-order and times of the friends graph", func(t *testing.T) {
+		g := orderedFriendsGraph(KeyOrder)
+
+		orders := g.DepthFirstOrders()
+
+		assert.Equal(t, g.DepthFirstTraversal(), orders.PreOrder)
+		assert.Equal(t,
+			[]string{"Candy", "Helen", "Fred", "Bob", "Elaine", "Irena", "Gina", "Derek", "Alice", "Zed", "Yara"},
+			orders.PostOrder)
+		assert.Equal(t, 1, orders.Discovery["Alice"])
+		assert.Equal(t, 18, orders.Finish["Alice"])
+		assert.Equal(t, 22, orders.Finish["Yara"])
+	})
+
+	// Happy Path
+	t.Run("Matches a recursive DFS on random graphs", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(24))
+		for trial := 0; trial < 20; trial++ {
+			g := NewOrderedDirectedGraph[int](KeyOrder)
+			for i := 0; i < 40; i++ {
+				g.AddEdge(rng.Intn(30), rng.Intn(30), 1)
+			}
+
+			visited := map[int]bool{}
+			pre, post := []int{}, []int{}
+			var recurse func(node int)
+			recurse = func(node int) {
+				visited[node] = true
+				pre = append(pre, node)
+				for _, neighbor := range g.OutNeighbors(node) {
+					if !visited[neighbor] {
+						recurse(neighbor)
+					}
+				}
+				post = append(post, node)
+			}
+			for _, node := range g.Nodes() {
+				if !visited[node] {
+					recurse(node)
+				}
+			}
+
+			orders := g.DepthFirstOrders()
+			assert.Equal(t, pre, orders.PreOrder)
+			assert.Equal(t, post, orders.PostOrder)
+		}
+	})
+
+	// Happy Path
+	t.Run("Times nest like parentheses", func(t *testing.T) {
+		orders := friendsGraph().DepthFirstOrders()
+
+		seen := map[int]bool{}
+		for _, a := range orders.PreOrder {
+			assert.Less(t, orders.Discovery[a], orders.Finish[a])
+			seen[orders.Discovery[a]], seen[orders.Finish[a]] = true, true
+			for _, b := range orders.PreOrder {
+				inside := orders.Discovery[a] < orders.Discovery[b] && orders.Finish[b] < orders.Finish[a]
+				outside := orders.Finish[b] < orders.Discovery[a] || orders.Finish[a] < orders.Discovery[b]
+				assert.True(t, a == b || inside || outside || orders.Discovery[b] < orders.Discovery[a])
+			}
+		}
+		assert.Len(t, seen, 2*len(orders.PreOrder))
+	})
+
+	// Edge Case
+	t.Run("A million-node chain does not recurse", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("builds a million-node graph")
+		}
+		const n = 1000000
+		g := NewOrderedGraph[int](KeyOrder)
+		for i := 1; i < n; i++ {
+			g.AddEdge(i-1, i, 1)
+		}
+		// goroutine stacks grow to 1 GB by default, enough for a recursive walk of
+		// the chain; 16 MiB is not
+		defer debug.SetMaxStack(debug.SetMaxStack(16 << 20))
+
+		orders := g.DepthFirstOrders()
+
+		assert.Len(t, orders.PreOrder, n)
+		assert.Len(t, orders.PostOrder, n)
+		assert.Equal(t, 0, orders.PreOrder[0])
+		assert.Equal(t, n-1, orders.PreOrder[n-1])
+		assert.Equal(t, n-1, orders.PostOrder[0])
+		assert.Equal(t, 2*n, orders.Finish[0])
+		assert.Len(t, g.DepthFirstTraversal(), n)
+	})
+}